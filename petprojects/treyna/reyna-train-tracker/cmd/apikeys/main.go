@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"reyna-train-tracker/internal/auth"
+	"reyna-train-tracker/internal/config"
+)
+
+const usage = `Ключи API (файл AUTH_KEYS_PATH; запущенный сервер подхватит изменения сам):
+
+  apikeys create --name family --scopes position,timetable [--rate 60] [--quota 5000]
+  apikeys list
+  apikeys revoke <id>
+
+Права: position, timetable, admin (admin включает остальные)`
+
+// Управление ключами API без сервера: первый ключ admin создаётся здесь
+func main() {
+	flag.Usage = func() { fmt.Fprintln(os.Stderr, usage) }
+	keysPath := flag.String("file", "", "файл ключей (по умолчанию AUTH_KEYS_PATH)")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+	}
+	path := *keysPath
+	if path == "" {
+		path = cfg.AuthKeysPath
+	}
+	store, err := auth.NewStore(path)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки ключей: %v", err)
+	}
+
+	switch command, args := flag.Arg(0), flag.Args()[1:]; command {
+	case "create":
+		create(store, args)
+	case "list":
+		list(store)
+	case "revoke":
+		if len(args) != 1 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		if err := store.Revoke(args[0]); err != nil {
+			if errors.Is(err, auth.ErrKeyNotFound) {
+				log.Fatalf("❌ Ключ %s не найден", args[0])
+			}
+			log.Fatalf("❌ Ошибка отзыва ключа: %v", err)
+		}
+		fmt.Printf("🗑  Ключ %s отозван\n", args[0])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func create(store *auth.Store, args []string) {
+	fs := flag.NewFlagSet("create", flag.ExitOnError)
+	name := fs.String("name", "", "название ключа (кому выдан)")
+	scopes := fs.String("scopes", string(auth.ScopePosition), "права через запятую")
+	rate := fs.Int("rate", 0, "запросов в минуту (0 - AUTH_RATE_PER_MINUTE)")
+	quota := fs.Int("quota", 0, "запросов в сутки (0 - AUTH_DAILY_QUOTA)")
+	fs.Parse(args)
+
+	parsed, err := auth.ParseScopes(*scopes)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	key, token, err := auth.CreateKey(store, *name, parsed, *rate, *quota, time.Now())
+	if err != nil {
+		log.Fatalf("❌ Ошибка создания ключа: %v", err)
+	}
+
+	fmt.Printf("🔑 Ключ %s (%s), права: %s\n", key.ID, key.Name, joinScopes(key.Scopes))
+	fmt.Println("   Сохрани его сейчас - в файле остаётся только хеш:")
+	fmt.Printf("\n   %s\n\n", token)
+	fmt.Println("   curl -H 'Authorization: Bearer <ключ>' localhost:8080/api/position")
+}
+
+func list(store *auth.Store) {
+	keys := store.Keys()
+	if len(keys) == 0 {
+		fmt.Println("🔑 Ключей нет")
+		return
+	}
+	for _, key := range keys {
+		limits := []string{}
+		if key.RatePerMinute > 0 {
+			limits = append(limits, fmt.Sprintf("%d/мин", key.RatePerMinute))
+		}
+		if key.DailyQuota > 0 {
+			limits = append(limits, fmt.Sprintf("%d/сутки", key.DailyQuota))
+		}
+		if len(limits) == 0 {
+			limits = append(limits, "лимиты по умолчанию")
+		}
+		fmt.Printf("🔑 %s  %-12s %s…  %-26s %s  создан %s\n",
+			key.ID, key.Name, key.Prefix, joinScopes(key.Scopes), strings.Join(limits, ", "),
+			key.CreatedAt.Format("02.01.2006 15:04"))
+	}
+}
+
+func joinScopes(scopes []auth.Scope) string {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	return strings.Join(names, ",")
+}
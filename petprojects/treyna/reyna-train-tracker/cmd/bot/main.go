@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/bot"
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/metrics"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// Чат и токен для режима --fake
+const (
+	fakeToken  = "fake-token"
+	fakeChatID = 1
+)
+
+// Бот для семейного чата: /where, /time, /next, /call и уведомления о событиях поездки.
+// Адрес Bot API задаётся BOT_API_URL; с --fake бот работает с локальным
+// фальшивым API, а команды читаются со стандартного ввода
+func main() {
+	langFlag := flag.String("lang", "", "язык по умолчанию: ru или en (по умолчанию DEFAULT_LANG)")
+	atFlag := flag.String("at", "", "момент поездки в RFC3339 (по умолчанию CLOCK_START или текущее время)")
+	fake := flag.Bool("fake", false, "локальный фальшивый Bot API, команды со стандартного ввода")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+	}
+	if *langFlag != "" {
+		cfg.Language = *langFlag
+	}
+	if *atFlag != "" {
+		if cfg.ClockStart, err = time.Parse(time.RFC3339, *atFlag); err != nil {
+			log.Fatalf("❌ --at: ожидается RFC3339: %v", err)
+		}
+	}
+	if !*fake && cfg.BotToken == "" {
+		log.Fatal("❌ Не задан BOT_TOKEN (или запустите с --fake)")
+	}
+
+	trainTracker, err := tracker.NewTrainTrackerWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки расписания: %v", err)
+	}
+	defer trainTracker.Close()
+
+	handler := api.NewQuestionHandlerWithConfig(trainTracker, cfg, metrics.NewMetricsCollector())
+	defer handler.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client := bot.NewClient(cfg.BotAPIURL, cfg.BotToken)
+	if *fake {
+		fakeAPI := bot.NewFakeAPI(fakeToken)
+		baseURL, closeFake, err := serveFake(fakeAPI)
+		if err != nil {
+			log.Fatalf("❌ Не удалось запустить фальшивый Bot API: %v", err)
+		}
+		defer closeFake()
+
+		client = bot.NewClient(baseURL, fakeToken)
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		go chat(ctx, cancel, fakeAPI, i18n.ParseOrDefault(cfg.Language))
+		fmt.Println(i18n.T(i18n.ParseOrDefault(cfg.Language), "bot.fake_ready", baseURL))
+	}
+
+	b := bot.NewBotWithConfig(client, handler, cfg)
+	if *fake {
+		b.Subscribe(fakeChatID, b.Language)
+	}
+
+	fmt.Printf("🤖 Бот запущен: %s, подписанных чатов: %d\n", client.BaseURL, len(b.Subscribers()))
+	if err := b.Run(ctx); err != nil {
+		log.Fatalf("❌ Ошибка бота: %v", err)
+	}
+	fmt.Println("✅ Бот остановлен")
+}
+
+// serveFake запускает FakeAPI на свободном локальном порту
+func serveFake(fakeAPI *bot.FakeAPI) (string, func(), error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", nil, err
+	}
+
+	srv := &http.Server{Handler: fakeAPI, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("⚠️  Фальшивый Bot API: %v", err)
+		}
+	}()
+	return "http://" + listener.Addr().String(), func() { srv.Close() }, nil
+}
+
+// chat передаёт строки стандартного ввода в фальшивый API и печатает ответы бота
+func chat(ctx context.Context, cancel context.CancelFunc, fakeAPI *bot.FakeAPI, lang i18n.Lang) {
+	go func() {
+		printed := 0
+		for {
+			sent, err := fakeAPI.WaitSent(ctx, printed+1)
+			for _, m := range sent[printed:] {
+				fmt.Printf("💬 %s\n\n", m.Text)
+			}
+			printed = len(sent)
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	user := bot.User{ID: fakeChatID, FirstName: "family", LanguageCode: string(lang)}
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "/quit" {
+			break
+		}
+		if line != "" {
+			fakeAPI.Send(fakeChatID, user, line)
+		}
+	}
+	// Даём боту ответить на последнюю команду (при вводе из файла)
+	time.Sleep(500 * time.Millisecond)
+	cancel()
+}
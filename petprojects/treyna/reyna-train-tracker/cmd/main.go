@@ -1,69 +1,109 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
+	"os"
 	"sort"
 	"strings"
 	"time"
 
 	"reyna-train-tracker/internal/api"
 	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/i18n"
 	"reyna-train-tracker/internal/metrics"
 	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/timeline"
 	"reyna-train-tracker/internal/tracker"
 	"reyna-train-tracker/internal/utils"
 )
 
+// demoTime момент поездки по умолчанию: 11.10.2025 10:00 по Москве
+var demoTime = time.Date(2025, 10, 11, 10, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+// lang язык вывода: флаг --lang или DEFAULT_LANG
+var lang = i18n.Default
+
+// tr переводит сообщение на язык вывода
+func tr(key string, args ...interface{}) string {
+	return i18n.T(lang, key, args...)
+}
+
 func main() {
-	fmt.Println("🚂 ТРЕКЕР РЭЙНЫ - Система отслеживания поезда Москва-Хабаровск")
-	fmt.Println(strings.Repeat("=", 80))
+	langFlag := flag.String("lang", "", "язык вывода: ru или en (по умолчанию DEFAULT_LANG)")
+	atFlag := flag.String("at", "", "момент поездки в RFC3339 (по умолчанию CLOCK_START или демонстрационный)")
+	flag.Parse()
 
 	// Загружаем конфигурацию из environment variables
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+		log.Fatal(tr("cli.config_error", err))
+	}
+
+	requested := cfg.Language
+	if *langFlag != "" {
+		requested = *langFlag
 	}
+	lang = i18n.ParseOrDefault(requested)
+	if _, ok := i18n.Parse(requested); !ok {
+		fmt.Println(tr("cli.lang_unsupported", requested, lang))
+	}
+
+	// Момент поездки задаёт часы трекера: --at, затем CLOCK_START,
+	// иначе демонстрационный момент в середине пути
+	if *atFlag != "" {
+		if cfg.ClockStart, err = time.Parse(time.RFC3339, *atFlag); err != nil {
+			log.Fatal(tr("cli.config_error", err))
+		}
+	}
+	if cfg.ClockStart.IsZero() {
+		cfg.ClockStart = demoTime
+	}
+
+	fmt.Println(tr("cli.title"))
+	fmt.Println(strings.Repeat("=", 80))
 
-	fmt.Printf("⚙️  Конфигурация загружена:\n")
-	fmt.Printf("   Максимум одновременных запросов: %d\n", cfg.MaxConcurrentRequests)
-	fmt.Printf("   Лимит запросов в секунду: %d\n", cfg.RateLimitPerSecond)
-	fmt.Printf("   Время жизни кэша: %v\n", cfg.CacheTTL)
-	fmt.Printf("   Количество воркеров: %d\n", cfg.NumWorkers)
-	fmt.Printf("   Максимум повторов: %d\n", cfg.MaxRetries)
+	fmt.Println(tr("cli.config_loaded"))
+	fmt.Println(tr("cli.config_max_concurrent", cfg.MaxConcurrentRequests))
+	fmt.Println(tr("cli.config_rate_limit", cfg.RateLimitPerSecond))
+	fmt.Println(tr("cli.config_cache_ttl", cfg.CacheTTL))
+	fmt.Println(tr("cli.config_cache_size", cfg.CacheMaxEntries, cfg.CacheEvictionPolicy))
+	fmt.Println(tr("cli.config_workers", cfg.NumWorkers, cfg.LoadBalancerStrategy))
+	fmt.Println(tr("cli.config_retries", cfg.MaxRetries))
 	fmt.Println()
 
 	// Инициализируем сборщик метрик
 	metricsCollector := metrics.NewMetricsCollector()
 
 	// Загружаем данные маршрута
-	trainTracker, err := tracker.NewTrainTracker(cfg.JSONDataPath)
+	trainTracker, err := tracker.NewTrainTrackerWithConfig(cfg)
 	if err != nil {
-		log.Fatalf("❌ Ошибка загрузки расписания: %v", err)
+		log.Fatal(tr("cli.schedule_error", err))
 	}
+	defer trainTracker.Close()
 
-	fmt.Printf("✅ Загружено станций: %d\n", len(trainTracker.Stations))
-	fmt.Printf("📏 Общая дистанция: %d км\n", trainTracker.RouteData.TotalDistance)
-	fmt.Printf("🕐 Начало путешествия: %s\n\n", trainTracker.RouteData.StartTime.Format("15:04 02.01.2006"))
+	fmt.Println(tr("cli.stations_loaded", len(trainTracker.Stations)))
+	fmt.Println(tr("cli.total_distance", trainTracker.RouteData.TotalDistance))
+	fmt.Println(tr("cli.journey_start", trainTracker.RouteData.StartTime.Format("15:04 02.01.2006")) + "\n")
 
 	// Создаём обработчик вопросов с конфигурацией и метриками
 	handler := api.NewQuestionHandlerWithConfig(trainTracker, cfg, metricsCollector)
+	handler.Language = lang
+	defer handler.Close()
 
-	// Текущее время (можно изменить для тестирования)
-	// Используем текущее время
-	// currentTime := time.Now()
-	
-	// Или можно использовать конкретное время для тестирования:
-	moscowTZ, _ := time.LoadLocation("Europe/Moscow")
-	currentTime := time.Date(2025, 10, 11, 10, 0, 0, 0, moscowTZ)
+	// Текущее время по часам трекера (CLOCK_START / CLOCK_SPEED или --at)
+	currentTime := trainTracker.Now()
 
-	fmt.Printf("🕐 Текущее время: %s (Москва)\n", currentTime.Format("15:04 02.01.2006"))
+	fmt.Println(tr("cli.current_time", currentTime.Format("15:04 02.01.2006")))
 	fmt.Println(strings.Repeat("=", 80))
 
 	// Отладочная информация
 	if cfg.DebugMode {
 		tracker.DebugFindCurrentPosition(trainTracker.Stations, currentTime)
-		trainTracker.DebugAllStations()
+		if err := timeline.Render(os.Stdout, timeline.FormatText, lang, trainTracker.RouteData, trainTracker.Stations); err != nil {
+			fmt.Println(err)
+		}
 	}
 
 	// Получаем текущую позицию с измерением времени
@@ -73,25 +113,25 @@ func main() {
 	metricsCollector.RecordRequest(posDuration, position != nil)
 
 	if position != nil {
-		fmt.Println("\n📍 ТЕКУЩАЯ ПОЗИЦИЯ:")
+		fmt.Println("\n" + tr("cli.position_header"))
 		fmt.Println(strings.Repeat("-", 80))
 		
 		if position.IsAtStation && position.CurrentStation != nil {
-			fmt.Printf("🚉 Станция: %s\n", position.CurrentStation.Name)
-			fmt.Printf("📏 Расстояние от Москвы: %d км\n", position.CurrentStation.DistanceFromStart)
-			fmt.Printf("🌍 Часовой пояс: %s\n", position.Timezone)
+			fmt.Println(tr("cli.station", i18n.StationName(lang, position.CurrentStation.Name)))
+			fmt.Println(tr("cli.distance_from_moscow", position.CurrentStation.DistanceFromStart))
+			fmt.Println(tr("cli.timezone", position.Timezone))
 			
 			localTime, _ := utils.ConvertToTimezone(currentTime, position.Timezone)
-			fmt.Printf("🕐 Локальное время: %s\n", localTime.Format("15:04 02.01.2006"))
+			fmt.Println(tr("cli.local_time", localTime.Format("15:04 02.01.2006")))
 		} else {
-			fmt.Printf("🚂 В пути между станциями:\n")
+			fmt.Println(tr("cli.between_stations"))
 			if position.PreviousStation != nil {
-				fmt.Printf("   ├─ Предыдущая: %s\n", position.PreviousStation.Name)
+				fmt.Println(tr("cli.previous_station", i18n.StationName(lang, position.PreviousStation.Name)))
 			}
 			if position.NextStation != nil {
-				fmt.Printf("   └─ Следующая: %s\n", position.NextStation.Name)
+				fmt.Println(tr("cli.next_station", i18n.StationName(lang, position.NextStation.Name)))
 			}
-			fmt.Printf("📏 Приблизительное расстояние от Москвы: %.0f км\n", position.DistanceFromStart)
+			fmt.Println(tr("cli.approx_distance", position.DistanceFromStart))
 		}
 		
 		// Статус поезда
@@ -101,11 +141,11 @@ func main() {
 		metricsCollector.RecordRequest(statusDuration, true)
 
 		if status.IsMoving {
-			fmt.Printf("🚂 Статус: В ДВИЖЕНИИ\n")
-			fmt.Printf("⏰ До следующей станции: %s\n", utils.FormatDuration(status.TimeToNext))
+			fmt.Println(tr("cli.status_moving"))
+			fmt.Println(tr("cli.time_to_next", i18n.FormatDuration(lang, status.TimeToNext)))
 		} else {
-			fmt.Printf("🛑 Статус: СТОИТ НА СТАНЦИИ\n")
-			fmt.Printf("⏰ Осталось стоять: %s\n", utils.FormatDuration(status.RemainingStand))
+			fmt.Println(tr("cli.status_standing"))
+			fmt.Println(tr("cli.remaining_stand", i18n.FormatDuration(lang, status.RemainingStand)))
 		}
 		
 		// Информация о путешествии
@@ -114,15 +154,18 @@ func main() {
 		journeyDuration := time.Since(startJourney)
 		metricsCollector.RecordRequest(journeyDuration, true)
 
-		fmt.Printf("\n📅 ИНФОРМАЦИЯ О ПУТЕШЕСТВИИ:\n")
-		fmt.Printf("   День путешествия: %d\n", journeyInfo.DayNumber)
-		fmt.Printf("   Время в пути: %s\n", utils.FormatDuration(journeyInfo.TotalTimeInTrip))
+		fmt.Println("\n" + tr("cli.journey_header"))
+		fmt.Println(tr("cli.journey_day", journeyInfo.DayNumber))
+		if journeyInfo.CalendarDay > 0 {
+			fmt.Println(tr("cli.calendar_day", journeyInfo.CalendarDay, journeyInfo.LocalDate.Format("02.01.2006")))
+		}
+		fmt.Println(tr("cli.time_in_trip", i18n.FormatDuration(lang, journeyInfo.TotalTimeInTrip)))
 	} else {
-		fmt.Println("❌ Не удалось определить текущую позицию")
+		fmt.Println(tr("cli.position_unknown"))
 	}
 
 	fmt.Println("\n" + strings.Repeat("=", 80))
-	fmt.Println("🔍 ОБРАБОТКА ВСЕХ 10 ВОПРОСОВ С ИСПОЛЬЗОВАНИЕМ ПАТТЕРНОВ КОНКУРЕНТНОСТИ...")
+	fmt.Println(tr("cli.processing_header"))
 	fmt.Println("   (WaitGroup, Semaphore, RateLimiter, LoadBalancer, Fan-in/Fan-out)")
 	fmt.Println(strings.Repeat("=", 80))
 
@@ -141,13 +184,13 @@ func main() {
 	var results []models.QuestionResult
 	if newDuration < oldDuration * 2 { // Если новая версия не значительно медленнее
 		results = newResults
-		fmt.Printf("✅ Использована улучшенная версия с retry (время: %v)\n", newDuration)
+		fmt.Println(tr("cli.used_retry", newDuration))
 	} else {
 		results = oldResults  
-		fmt.Printf("✅ Использована стандартная версия (время: %v)\n", oldDuration)
+		fmt.Println(tr("cli.used_standard", oldDuration))
 	}
 	questionsDuration := time.Since(startQuestions)
-	metricsCollector.RecordRequest(questionsDuration, len(results) == 10)
+	metricsCollector.RecordRequest(questionsDuration, len(results) == handler.Questions.Len())
 
 	// Сортируем результаты по номеру вопроса
 	sort.Slice(results, func(i, j int) bool {
@@ -159,49 +202,53 @@ func main() {
 
 	// Статистика использования
 	fmt.Println("\n" + strings.Repeat("=", 80))
-	fmt.Println("📊 СТАТИСТИКА ИСПОЛЬЗОВАНИЯ:")
+	fmt.Println(tr("cli.stats_header"))
 	fmt.Println(strings.Repeat("-", 80))
 	
 	stats := trainTracker.GetStatistics()
-	fmt.Printf("Всего запросов: %v\n", stats["total_requests"])
-	fmt.Printf("Размер кэша: %v записей\n", stats["cache_size"])
+	fmt.Println(tr("cli.total_requests", stats["total_requests"]))
+	fmt.Println(tr("cli.cache_size", stats["cache_size"], stats["cache_capacity"]))
+	fmt.Println(tr("cli.cache_evictions", stats["cache_evictions"], stats["cache_expirations"]))
 	
-	if questionStats, ok := stats["question_counters"].(map[string]uint64); ok {
-		fmt.Println("\nЗапросов по вопросам:")
-		for i := 1; i <= 10; i++ {
-			key := fmt.Sprintf("question_%d", i)
-			fmt.Printf("  Вопрос %d: %d раз(а)\n", i, questionStats[key])
-		}
+	fmt.Println("\n" + tr("cli.questions_header"))
+	for _, question := range handler.Questions.All() {
+		fmt.Println(tr("cli.question_count", question.ID(), trainTracker.QuestionCount(question.ID())))
 	}
 
 	// Статистика Load Balancer
-	fmt.Println("\n📊 СТАТИСТИКА LOAD BALANCER:")
+	fmt.Println("\n" + tr("cli.lb_header", handler.LoadBalancer.Strategy()))
 	workerStats := handler.LoadBalancer.GetWorkerStats()
 	for _, stat := range workerStats {
-		fmt.Printf("  Worker %v: нагрузка = %v, активен = %v\n", 
-			stat["id"], stat["load"], stat["active"])
+		fmt.Println(tr("cli.lb_worker",
+			stat["id"], stat["load"], stat["active"], stat["weight"], stat["successes"], stat["failures"]))
 	}
 
+	// Статистика очереди пула воркеров
+	queueStats := handler.Pool.GetQueueStats()
+	fmt.Println("\n" + tr("cli.queue_stats",
+		queueStats["queue_depth"], queueStats["queue_max_depth"], queueStats["queue_capacity"],
+		queueStats["submitted"], queueStats["rejected"]))
+
 	// Статистика Rate Limiter
-	fmt.Printf("\n📊 RATE LIMITER: доступно токенов = %d\n", handler.RateLimiter.GetTokenCount())
+	fmt.Println("\n" + tr("cli.rate_limiter", handler.RateLimiter.GetTokenCount()))
 
 	// Метрики производительности
-	fmt.Println("\n📈 МЕТРИКИ ПРОИЗВОДИТЕЛЬНОСТИ:")
+	fmt.Println("\n" + tr("cli.metrics_header"))
 	performanceMetrics := metricsCollector.GetMetrics()
-	fmt.Printf("  Всего обработано запросов: %v\n", performanceMetrics["total_requests"])
-	fmt.Printf("  Среднее время запроса: %v\n", performanceMetrics["avg_request_time"])
-	fmt.Printf("  Процент ошибок: %v\n", performanceMetrics["error_rate_percent"])
-	fmt.Printf("  Попаданий в кэш: %v\n", performanceMetrics["cache_hits"])
-	fmt.Printf("  Промахов кэша: %v\n", performanceMetrics["cache_misses"])
-	fmt.Printf("  Эффективность кэша: %v\n", performanceMetrics["cache_hit_rate"])
-	fmt.Printf("  Время обработки 10 вопросов: %v\n", questionsDuration)
+	fmt.Println(tr("cli.metrics_total", performanceMetrics["total_requests"]))
+	fmt.Println(tr("cli.metrics_avg", performanceMetrics["avg_request_time"]))
+	fmt.Println(tr("cli.metrics_errors", performanceMetrics["error_rate_percent"]))
+	fmt.Println(tr("cli.metrics_hits", performanceMetrics["cache_hits"]))
+	fmt.Println(tr("cli.metrics_misses", performanceMetrics["cache_misses"]))
+	fmt.Println(tr("cli.metrics_hit_rate", performanceMetrics["cache_hit_rate"]))
+	fmt.Println(tr("cli.questions_time", questionsDuration))
 
 	// Время выполнения программы
 	totalDuration := time.Since(startPos)
-	fmt.Printf("\n⏱️  Общее время выполнения программы: %v\n", totalDuration)
+	fmt.Println("\n" + tr("cli.total_time", totalDuration))
 
 	fmt.Println("\n" + strings.Repeat("=", 80))
-	fmt.Println("✅ Программа успешно завершена!")
+	fmt.Println(tr("cli.done"))
 	fmt.Println(strings.Repeat("=", 80))
 }
 
@@ -222,80 +269,104 @@ func printResults(results []models.QuestionResult) {
 			// Специальная обработка для каждого вопроса
 			switch result.QuestionNumber {
 			case 1: // Локальное время
-				fmt.Printf("   🕐 Локальное время: %v\n", answerMap["local_time"])
-				fmt.Printf("   🌍 Часовой пояс: %v\n", answerMap["timezone"])
+				fmt.Println(tr("cli.q_local_time", answerMap["local_time"]))
+				fmt.Println(tr("cli.q_timezone", answerMap["timezone"]))
 				
 			case 2: // Текущая станция
 				if answerMap["at_station"] == true {
-					fmt.Printf("   🚉 Станция: %v\n", answerMap["station"])
-					fmt.Printf("   📏 Расстояние от Москвы: %v км\n", answerMap["distance_from_moscow"])
+					fmt.Println(tr("cli.q_station", answerMap["station"]))
+					fmt.Println(tr("cli.q_distance", answerMap["distance_from_moscow"]))
 				} else {
-					fmt.Printf("   🚂 Между станциями:\n")
-					fmt.Printf("      Предыдущая: %v\n", answerMap["previous"])
-					fmt.Printf("      Следующая: %v\n", answerMap["next"])
-					fmt.Printf("   📏 Расстояние от Москвы: ~%v км\n", answerMap["distance_from_moscow"])
+					fmt.Println(tr("cli.q_between"))
+					fmt.Println(tr("cli.q_previous", answerMap["previous"]))
+					fmt.Println(tr("cli.q_next", answerMap["next"]))
+					fmt.Println(tr("cli.q_approx_distance", answerMap["distance_from_moscow"]))
 				}
 				
 			case 3: // Статус поезда
 				status := answerMap["status"]
 				fmt.Printf("   %s\n", status)
-				if status == "СТОИТ" {
-					fmt.Printf("   🚉 Станция: %v\n", answerMap["station"])
-					fmt.Printf("   ⏰ Время стоянки: %v\n", answerMap["stand_duration"])
-					fmt.Printf("   ⏳ Осталось стоять: %v\n", answerMap["remaining_stand"])
+				if status == i18n.T(lang, "answer.status_standing") {
+					fmt.Println(tr("cli.q_station", answerMap["station"]))
+					fmt.Println(tr("cli.q_stand_duration", answerMap["stand_duration"]))
+					fmt.Println(tr("cli.q_remaining_stand", answerMap["remaining_stand"]))
 				} else {
-					fmt.Printf("   📍 От: %v\n", answerMap["from"])
-					fmt.Printf("   📍 До: %v\n", answerMap["to"])
-					fmt.Printf("   ⏰ Время до следующей станции: %v\n", answerMap["time_to_next"])
+					fmt.Println(tr("cli.q_from", answerMap["from"]))
+					fmt.Println(tr("cli.q_to", answerMap["to"]))
+					fmt.Println(tr("cli.q_time_to_next", answerMap["time_to_next"]))
 				}
 				
 			case 4: // День путешествия
-				fmt.Printf("   📅 День путешествия: %v\n", answerMap["day_number"])
-				fmt.Printf("   🚀 Начало: %v\n", answerMap["start_date"])
-				fmt.Printf("   ⏱️  Время в пути: %v\n", answerMap["time_in_trip"])
+				fmt.Println(tr("cli.q_journey_day", answerMap["day_number"]))
+				if day, ok := answerMap["calendar_day"]; ok {
+					fmt.Println(tr("cli.q_calendar_day", day, answerMap["local_date"]))
+				}
+				if next, ok := answerMap["next_date_change"]; ok {
+					fmt.Println(tr("cli.q_next_date_change", next))
+				}
+				fmt.Println(tr("cli.q_start", answerMap["start_date"]))
+				fmt.Println(tr("cli.q_time_in_trip", answerMap["time_in_trip"]))
 				
 			case 5: // Расстояние
-				fmt.Printf("   📏 Расстояние от Москвы: %v км\n", answerMap["distance_km"])
-				fmt.Printf("   📍 Местоположение: %v\n", answerMap["location"])
+				fmt.Println(tr("cli.q_distance", answerMap["distance_km"]))
+				fmt.Println(tr("cli.q_location", answerMap["location"]))
 				
 			case 6: // Следующая станция
-				fmt.Printf("   🚉 Следующая станция: %v\n", answerMap["next_station"])
-				fmt.Printf("   ⏰ Время прибытия: %v\n", answerMap["arrival_time"])
-				fmt.Printf("   ⏳ Осталось в пути: %v\n", answerMap["time_remaining"])
+				fmt.Println(tr("cli.q_next_station", answerMap["next_station"]))
+				fmt.Println(tr("cli.q_arrival_time", answerMap["arrival_time"]))
+				fmt.Println(tr("cli.q_scheduled_time", answerMap["scheduled_time"]))
+				fmt.Println(tr("cli.q_arrival_window", answerMap["confidence"], answerMap["arrival_window"]))
+				if delay, ok := answerMap["delay"]; ok {
+					fmt.Println(tr("cli.q_delay", delay))
+				}
+				fmt.Println(tr("cli.q_time_remaining", answerMap["time_remaining"]))
 				
 			case 7: // Разница во времени
-				fmt.Printf("   🕐 Время в Москве: %v\n", answerMap["moscow_time"])
-				fmt.Printf("   🕐 Локальное время: %v\n", answerMap["local_time"])
-				fmt.Printf("   ⏰ Разница: %v\n", answerMap["difference"])
+				fmt.Println(tr("cli.q_moscow_time", answerMap["moscow_time"]))
+				fmt.Println(tr("cli.q_local_time", answerMap["local_time"]))
+				fmt.Println(tr("cli.q_difference", answerMap["difference"]))
 				fmt.Printf("   ➡️  %v\n", answerMap["direction"])
+				if warning, ok := answerMap["clock_change"]; ok {
+					fmt.Println(tr("cli.q_clock_change", warning))
+				}
 				
 			case 8: // Сообщение ей
-				fmt.Printf("   📱 Время отправки (Москва): %v\n", answerMap["send_time_moscow"])
-				fmt.Printf("   📨 Время получения (у неё): %v\n", answerMap["receive_time_local"])
+				fmt.Println(tr("cli.q_send_moscow", answerMap["send_time_moscow"]))
+				fmt.Println(tr("cli.q_receive_her", answerMap["receive_time_local"]))
 				fmt.Printf("   ⚡ %v\n", answerMap["note"])
 				
 			case 9: // Сообщение от неё
-				fmt.Printf("   📱 Время отправки (у неё): %v\n", answerMap["send_time_local"])
-				fmt.Printf("   📨 Время получения (Москва): %v\n", answerMap["receive_time_moscow"])
+				fmt.Println(tr("cli.q_send_her", answerMap["send_time_local"]))
+				fmt.Println(tr("cli.q_receive_moscow", answerMap["receive_time_moscow"]))
 				fmt.Printf("   ⚡ %v\n", answerMap["note"])
 				
 			case 10: // Основные станции впереди
 				if stations, ok := answerMap["upcoming_stations"].([]map[string]interface{}); ok {
-					fmt.Printf("   🚉 Основных станций впереди: %v\n\n", answerMap["count"])
+					fmt.Println(tr("cli.q_major_ahead", answerMap["count"]) + "\n")
 					for i, station := range stations {
 						if i >= 5 { // Выводим первые 5 станций
-							fmt.Printf("   ... и ещё %d станций\n", len(stations)-5)
+							fmt.Println(tr("cli.q_more_stations", len(stations)-5))
 							break
 						}
 						fmt.Printf("   • %v\n", station["name"])
-						fmt.Printf("     ⏰ Прибытие: %v\n", station["arrival_time"])
-						fmt.Printf("     🕐 Стоянка: %v\n", station["stand_duration"])
-						fmt.Printf("     📏 Расстояние: %v км\n", station["distance"])
+						fmt.Println(tr("cli.q_arrival", station["arrival_time"]))
+						fmt.Println(tr("cli.q_stand", station["stand_duration"]))
+						fmt.Println(tr("cli.q_station_distance", station["distance"]))
 						if i < len(stations)-1 && i < 4 {
 							fmt.Println()
 						}
 					}
 				}
+
+			default: // Вопросы из реестра без особого оформления
+				keys := make([]string, 0, len(answerMap))
+				for key := range answerMap {
+					keys = append(keys, key)
+				}
+				sort.Strings(keys)
+				for _, key := range keys {
+					fmt.Printf("   • %s: %v\n", key, answerMap[key])
+				}
 			}
 		}
 	}
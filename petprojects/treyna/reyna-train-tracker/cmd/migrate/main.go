@@ -0,0 +1,112 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/storage"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// Миграция из reyna_route.json (и журналов JOURNAL_DIR) во встроенную базу.
+// Повторный запуск безопасен: станции маршрута заменяются, события не дублируются
+func main() {
+	dbPath := flag.String("db", "", "файл базы (по умолчанию STORAGE_PATH или reyna.db)")
+	jsonPath := flag.String("json", "", "расписание в JSON (по умолчанию JSON_DATA_PATH)")
+	routeID := flag.String("route", "", "ID маршрута (по умолчанию ROUTE_ID)")
+	journalDir := flag.String("journal", "", "каталог журналов *.jsonl для импорта (по умолчанию JOURNAL_DIR)")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+	}
+
+	path := firstNonEmpty(*dbPath, cfg.StoragePath, "reyna.db")
+	route := firstNonEmpty(*routeID, cfg.RouteID)
+	dir := firstNonEmpty(*journalDir, cfg.JournalDir)
+
+	// Расписание разбираем тем же кодом, что и трекер, но из JSON и без журнала
+	cfg.JSONDataPath = firstNonEmpty(*jsonPath, cfg.JSONDataPath)
+	cfg.StoragePath = ""
+	cfg.JournalEnabled = false
+	trainTracker, err := tracker.NewTrainTrackerWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки расписания: %v", err)
+	}
+	defer trainTracker.Close()
+
+	store, err := storage.Open(path)
+	if err != nil {
+		log.Fatalf("❌ Ошибка открытия базы: %v", err)
+	}
+	defer store.Close()
+
+	fmt.Printf("\n🗄  Миграция в %s\n", path)
+	fmt.Println(strings.Repeat("=", 80))
+
+	err = store.SaveRoute(storage.Route{
+		ID:            route,
+		Name:          trainTracker.RouteData.Name,
+		StartTime:     trainTracker.RouteData.StartTime,
+		TotalDistance: trainTracker.RouteData.TotalDistance,
+	}, trainTracker.Stations)
+	if err != nil {
+		log.Fatalf("❌ Ошибка сохранения маршрута: %v", err)
+	}
+	fmt.Printf("✅ Маршрут %s (%s): %d станций, %d км\n",
+		route, trainTracker.RouteData.Name, len(trainTracker.Stations), trainTracker.RouteData.TotalDistance)
+
+	if dir == "" {
+		return
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.jsonl"))
+	if err != nil {
+		log.Fatalf("❌ Ошибка поиска журналов: %v", err)
+	}
+	for _, file := range files {
+		tripID := strings.TrimSuffix(filepath.Base(file), ".jsonl")
+		if err := importJournal(store, route, tripID, file, trainTracker); err != nil {
+			log.Fatalf("❌ Ошибка импорта журнала %s: %v", file, err)
+		}
+	}
+}
+
+// importJournal переносит журнал поездки из файла в базу
+func importJournal(store *storage.Store, routeID, tripID, file string, t *tracker.TrainTracker) error {
+	events, err := journal.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.EnsureTrip(storage.Trip{
+		ID:        tripID,
+		RouteID:   routeID,
+		StartTime: t.RouteData.StartTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	imported, err := store.ImportEvents(tripID, events)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ Поездка %s: импортировано событий %d из %d\n", tripID, imported, len(events))
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
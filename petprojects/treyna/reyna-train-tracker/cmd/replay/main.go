@@ -0,0 +1,168 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// Повтор поездки по журналу: что говорило расписание в момент каждого события
+// и насколько фактическая поездка разошлась с плановой
+func main() {
+	dir := flag.String("dir", "", "каталог журналов (по умолчанию JOURNAL_DIR)")
+	trip := flag.String("trip", "", "ID поездки (по умолчанию TRIP_ID или дата отправления)")
+	file := flag.String("file", "", "путь к файлу журнала (вместо --dir и --trip)")
+	db := flag.String("db", "", "база с поездками (по умолчанию STORAGE_PATH)")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+	}
+
+	journalDir := cfg.JournalDir
+	if *dir != "" {
+		journalDir = *dir
+	}
+	tripID := cfg.TripID
+	if *trip != "" {
+		tripID = *trip
+	}
+
+	if *db != "" {
+		cfg.StoragePath = *db
+	}
+
+	// Повтор только читает журнал: запросы позиции не должны в него попадать
+	cfg.JournalEnabled = false
+	trainTracker, err := tracker.NewTrainTrackerWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки расписания: %v", err)
+	}
+	defer trainTracker.Close()
+
+	if tripID == "" {
+		tripID = trainTracker.RouteData.StartTime.Format("2006-01-02")
+	}
+
+	// Источник событий: явный файл, затем каталог журналов, затем база
+	var source string
+	var events []journal.Event
+	switch {
+	case *file != "":
+		source = *file
+		events, err = journal.ReadFile(source)
+	case journalDir != "":
+		source = filepath.Join(journalDir, tripID+".jsonl")
+		events, err = journal.ReadFile(source)
+	case trainTracker.Store != nil:
+		source = fmt.Sprintf("%s (поездка %s)", trainTracker.Store.Path(), tripID)
+		events, err = trainTracker.Store.Events(tripID)
+	default:
+		log.Fatal("❌ Не задан журнал: укажите --file, --dir, --db, JOURNAL_DIR или STORAGE_PATH")
+	}
+	if err != nil {
+		log.Fatalf("❌ Ошибка чтения журнала: %v", err)
+	}
+
+	fmt.Printf("📼 Журнал %s: %d событий\n", source, len(events))
+	fmt.Println(strings.Repeat("=", 80))
+
+	printTimeline(journal.Replay(trainTracker.Stations, events, trainTracker.GetCurrentPosition))
+	printReport(journal.Compare(trainTracker.Stations, trainTracker.RouteData.StartTime, events))
+}
+
+func printTimeline(steps []journal.ReplayStep) {
+	fmt.Println("\n🕰  Повтор по шкале времени:")
+	for _, step := range steps {
+		e := step.Event
+		line := fmt.Sprintf("  #%-4d %s  %-15s", e.Seq, e.At.Format("15:04 02.01"), e.Type)
+		if e.Station != "" {
+			line += " " + e.Station
+		}
+
+		switch e.Type {
+		case journal.EventArrival, journal.EventDeparture:
+			line += "  " + formatDelay(step.Delay)
+		case journal.EventDelay:
+			line += fmt.Sprintf("  опоздание %s", step.Delay)
+		case journal.EventPositionQuery:
+			line += fmt.Sprintf("  %.0f км, расхождение %+.1f км", e.DistanceKm, step.DistanceDrift)
+		}
+
+		if pos := step.Scheduled; pos != nil {
+			switch {
+			case pos.IsAtStation && pos.CurrentStation != nil:
+				line += fmt.Sprintf("  | по расписанию: %s", pos.CurrentStation.Name)
+			case pos.PreviousStation != nil && pos.NextStation != nil:
+				line += fmt.Sprintf("  | по расписанию: %s → %s", pos.PreviousStation.Name, pos.NextStation.Name)
+			}
+		}
+		if e.Note != "" {
+			line += fmt.Sprintf("  (%s)", e.Note)
+		}
+		fmt.Println(line)
+	}
+}
+
+func printReport(report journal.Report) {
+	fmt.Println("\n🚉 Станции (план / факт):")
+	for _, r := range report.Stations {
+		arrival, departure := "—", "—"
+		if r.HasArrival {
+			arrival = fmt.Sprintf("%s (%s)", r.ActualArrival.Format("15:04"), formatDelay(r.ArrivalDelay))
+		}
+		if r.HasDeparture {
+			departure = fmt.Sprintf("%s (%s)", r.ActualDeparture.Format("15:04"), formatDelay(r.DepartureDelay))
+		}
+		fmt.Printf("  День %d  %-25s прибытие %s / %s, отправление %s / %s\n",
+			r.Day, r.Station.Name,
+			r.Station.ArrivalTime.Format("15:04"), arrival,
+			r.Station.DepartureTime.Format("15:04"), departure)
+	}
+
+	fmt.Println("\n🛤  Перегоны:")
+	for _, s := range report.Segments {
+		fmt.Printf("  %s → %s: план %s, факт %s, %s\n",
+			s.From.Name, s.To.Name, s.Planned, s.Actual, formatLost(s.Lost))
+	}
+
+	fmt.Println("\n📅 По дням:")
+	for _, d := range report.Days {
+		fmt.Printf("  День %d: прибытий %d, среднее опоздание %s, максимальное %s, на перегонах %s",
+			d.Day, d.Arrivals, d.AvgDelay.Round(time.Minute), d.MaxDelay, formatLost(d.Lost))
+		if d.ReportedDelays > 0 {
+			fmt.Printf(", сообщений об опоздании %d (до %s)", d.ReportedDelays, d.MaxReported)
+		}
+		fmt.Println()
+	}
+}
+
+// formatDelay опоздание относительно расписания
+func formatDelay(d time.Duration) string {
+	switch {
+	case d > 0:
+		return "+" + d.String()
+	case d < 0:
+		return "раньше на " + (-d).String()
+	}
+	return "по расписанию"
+}
+
+// formatLost потеря или нагон времени на перегоне
+func formatLost(d time.Duration) string {
+	switch {
+	case d > 0:
+		return "потеряно " + d.String()
+	case d < 0:
+		return "нагнали " + (-d).String()
+	}
+	return "без потерь"
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/auth"
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/grpcserver"
+	"reyna-train-tracker/internal/metrics"
+	"reyna-train-tracker/internal/server"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/webhook"
+)
+
+// shutdownTimeout сколько ждём завершения текущих запросов при остановке
+const shutdownTimeout = 15 * time.Second
+
+func main() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+	}
+
+	trainTracker, err := tracker.NewTrainTrackerWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки расписания: %v", err)
+	}
+
+	hooks, err := webhook.NewDispatcherWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки вебхуков: %v", err)
+	}
+
+	keys, err := auth.NewAuthenticatorWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки ключей API: %v", err)
+	}
+	var grpcOpts []grpc.ServerOption
+	if keys == nil {
+		fmt.Printf("⚠️  Аутентификация выключена (AUTH_ALLOW_INSECURE=true): API, включая вебхуки и ключи, открыт всем\n")
+	} else {
+		if len(keys.Store.Keys()) == 0 {
+			fmt.Printf("⚠️  Ключей API нет, все запросы к API получат 401. Создай ключ: go run ./cmd/apikeys create --name admin --scopes admin\n")
+		}
+		grpcOpts = grpcserver.AuthOptions(keys)
+	}
+
+	handler := api.NewQuestionHandlerWithConfig(trainTracker, cfg, metrics.NewMetricsCollector())
+	srv := server.NewServer(cfg, trainTracker, handler, hooks, keys)
+	grpcSrv := grpcserver.NewGRPCServer(trainTracker, handler, grpcOpts...)
+
+	grpcListener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		log.Fatalf("❌ Ошибка запуска gRPC: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// События расписания уходят подписчикам по мере движения поезда
+	go hooks.PublishMilestones(ctx, trainTracker)
+
+	serveErr := make(chan error, 2)
+	go func() {
+		fmt.Printf("🌐 Сервер слушает порт %s\n", cfg.ServerPort)
+		serveErr <- srv.ListenAndServe()
+	}()
+	go func() {
+		fmt.Printf("📡 gRPC слушает порт %s\n", cfg.GRPCPort)
+		serveErr <- grpcSrv.Serve(grpcListener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		if err != nil {
+			log.Fatalf("❌ Ошибка сервера: %v", err)
+		}
+	case <-ctx.Done():
+	}
+
+	fmt.Println("🛑 Остановка сервера, дожидаемся текущих запросов...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// GracefulStop ждёт и потоки WatchPosition, поэтому по таймауту обрываем их
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(grpcStopped)
+	}()
+	go func() {
+		<-shutdownCtx.Done()
+		grpcSrv.Stop()
+	}()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Fatalf("❌ Ошибка при остановке сервера: %v", err)
+	}
+
+	<-grpcStopped
+
+	fmt.Println("✅ Сервер остановлен")
+}
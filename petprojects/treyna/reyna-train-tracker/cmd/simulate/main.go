@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/metrics"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/utils"
+)
+
+// maxValueLength длина значения ответа в текстовом выводе, дальше - многоточие
+const maxValueLength = 80
+
+// step шаг симуляции в потоке JSON Lines
+type step struct {
+	At         time.Time               `json:"at"`
+	LocalTime  string                  `json:"local_time"`
+	Kind       models.MilestoneKind    `json:"kind"`
+	Station    string                  `json:"station"`
+	Timezone   string                  `json:"timezone"`
+	ClockShift string                  `json:"clock_shift,omitempty"`
+	Date       string                  `json:"date,omitempty"`
+	Answers    []models.QuestionResult `json:"answers"`
+}
+
+// Ускоренная симуляция поездки: часы трекера идут в --speed раз быстрее,
+// на каждом прибытии, отправлении, границе поясов и смене даты
+// печатаются ответы на все вопросы. Для демонстраций и проверки файла маршрута
+func main() {
+	speed := flag.Float64("speed", 3600, "во сколько раз быстрее реального времени (3600 - час пути за секунду)")
+	from := flag.String("from", "", "начало симуляции в RFC3339 (по умолчанию отправление поезда)")
+	until := flag.String("until", "", "конец симуляции в RFC3339 (по умолчанию прибытие на конечную)")
+	langFlag := flag.String("lang", "", "язык ответов: ru или en (по умолчанию DEFAULT_LANG)")
+	jsonOut := flag.Bool("json", false, "поток JSON Lines: одна строка на шаг")
+	flag.Parse()
+
+	if *speed <= 0 {
+		log.Fatal("❌ --speed должен быть больше нуля")
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+	}
+	lang := cfg.Language
+	if *langFlag != "" {
+		lang = *langFlag
+	}
+
+	// Симуляция только читает расписание: её запросы позиции не пишутся в журнал
+	cfg.JournalEnabled = false
+	sim := clock.NewSimulated(time.Time{}, *speed)
+
+	// В режиме --json отчёт о загрузке расписания уходит в stderr,
+	// чтобы stdout оставался чистым потоком JSON Lines
+	if *jsonOut && cfg.TrackerReport == "stdout" {
+		cfg.TrackerReport = "stderr"
+	}
+	trainTracker, err := tracker.NewTrainTrackerWithClock(cfg, sim)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки расписания: %v", err)
+	}
+	defer trainTracker.Close()
+
+	if len(trainTracker.Stations) == 0 {
+		log.Fatal("❌ В маршруте нет станций")
+	}
+	start := parseTime(*from, "--from", trainTracker.RouteData.StartTime)
+	end := parseTime(*until, "--until", trainTracker.Stations[len(trainTracker.Stations)-1].ArrivalTime)
+	if end.Before(start) {
+		log.Fatal("❌ --until раньше --from")
+	}
+
+	handler := api.NewQuestionHandlerWithConfig(trainTracker, cfg, metrics.NewMetricsCollector())
+	handler.Language = i18n.ParseOrDefault(lang)
+	defer handler.Close()
+
+	milestones := trainTracker.Milestones(start, end)
+	if !*jsonOut {
+		fmt.Printf("🎬 Симуляция %s: %s - %s, ×%g, событий: %d\n",
+			trainTracker.RouteData.Name, formatMoscow(start), formatMoscow(end), *speed, len(milestones))
+		fmt.Printf("⏱  Продлится около %s\n", time.Duration(float64(end.Sub(start)) / *speed).Round(time.Second))
+		fmt.Println(strings.Repeat("=", 80))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	encoder := json.NewEncoder(os.Stdout)
+	began := time.Now()
+	sim.Set(start)
+
+	played := 0
+	for i, m := range milestones {
+		// Ждём, пока часы симуляции дойдут до события
+		if wait := m.At.Sub(sim.Now()); wait > 0 {
+			select {
+			case <-ctx.Done():
+				fmt.Fprintf(os.Stderr, "\n⏹  Симуляция остановлена на %s\n", formatMoscow(sim.Now()))
+				return
+			case <-sim.After(wait):
+			}
+		}
+
+		// Ответы считаются ровно на момент события, а не на момент пробуждения
+		results := handler.ProcessAllQuestions(m.At)
+		sort.Slice(results, func(a, b int) bool {
+			return results[a].QuestionNumber < results[b].QuestionNumber
+		})
+
+		if *jsonOut {
+			if err := encoder.Encode(newStep(m, results)); err != nil {
+				log.Fatalf("❌ Ошибка вывода: %v", err)
+			}
+		} else {
+			printStep(i+1, len(milestones), m, results)
+		}
+		played++
+	}
+
+	if !*jsonOut {
+		fmt.Println(strings.Repeat("=", 80))
+		fmt.Printf("🏁 Симуляция завершена: %d событий за %s\n", played, time.Since(began).Round(time.Millisecond))
+	}
+}
+
+// newStep запись шага для JSON Lines
+func newStep(m models.Milestone, results []models.QuestionResult) step {
+	s := step{
+		At:        m.At,
+		LocalTime: localTime(m),
+		Kind:      m.Kind,
+		Station:   m.Station,
+		Timezone:  m.Timezone,
+		Answers:   results,
+	}
+	if m.Kind == models.MilestoneTimezone {
+		s.ClockShift = formatShift(m.Shift)
+	}
+	if m.Kind == models.MilestoneDate {
+		s.Date = m.Date.Format("2006-01-02")
+	}
+	return s
+}
+
+// printStep печатает событие и ответы на вопросы
+func printStep(n, total int, m models.Milestone, results []models.QuestionResult) {
+	fmt.Printf("\n[%d/%d] 🕐 %s (местное %s, %s)\n", n, total, formatMoscow(m.At), localTime(m), m.Timezone)
+	fmt.Println(describe(m))
+	for _, result := range results {
+		fmt.Printf("   %2d. %s\n       %s\n", result.QuestionNumber, result.QuestionText, formatAnswer(result.Answer))
+	}
+}
+
+// describe строка события
+func describe(m models.Milestone) string {
+	switch m.Kind {
+	case models.MilestoneArrival:
+		return "🚉 Прибытие: " + m.Station
+	case models.MilestoneDeparture:
+		return "🚂 Отправление: " + m.Station
+	case models.MilestoneTimezone:
+		return fmt.Sprintf("🌍 Граница поясов после станции %s: %s, %s", m.Station, m.Timezone, formatShift(m.Shift))
+	case models.MilestoneDate:
+		return fmt.Sprintf("📅 Новая дата: %s (после станции %s)", m.Date.Format("02.01.2006"), m.Station)
+	}
+	return string(m.Kind)
+}
+
+// formatAnswer ответ одной строкой: поля по алфавиту, длинные значения обрезаны
+func formatAnswer(answer interface{}) string {
+	fields, ok := answer.(map[string]interface{})
+	if !ok {
+		return truncate(fmt.Sprint(answer))
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := fields[key]
+		if t, ok := value.(time.Time); ok {
+			value = t.Format("15:04 02.01.2006")
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", key, truncate(fmt.Sprint(value))))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// formatShift перевод часов на границе поясов
+func formatShift(shift time.Duration) string {
+	if shift == 0 {
+		return "без перевода часов"
+	}
+	return fmt.Sprintf("часы %+g ч", shift.Hours())
+}
+
+// localTime время события по часам пассажира
+func localTime(m models.Milestone) string {
+	local, err := utils.ConvertToTimezone(m.At, m.Timezone)
+	if err != nil {
+		return "—"
+	}
+	return local.Format("15:04 02.01")
+}
+
+// formatMoscow время по Москве, как в расписании
+func formatMoscow(t time.Time) string {
+	if moscow, err := utils.ConvertToTimezone(t, "Europe/Moscow"); err == nil {
+		t = moscow
+	}
+	return t.Format("15:04 02.01.2006")
+}
+
+// parseTime разбирает флаг в RFC3339 или возвращает значение по умолчанию
+func parseTime(raw, name string, fallback time.Time) time.Time {
+	if raw == "" {
+		return fallback
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		log.Fatalf("❌ %s: ожидается RFC3339: %v", name, err)
+	}
+	return t
+}
+
+func truncate(s string) string {
+	runes := []rune(s)
+	if len(runes) <= maxValueLength {
+		return s
+	}
+	return string(runes[:maxValueLength]) + "…"
+}
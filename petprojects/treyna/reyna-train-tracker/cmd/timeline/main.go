@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/timeline"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// Полное расписание маршрута: время по Москве и местное, стоянки, км,
+// день поездки и пояса. Текстовая таблица, Markdown или самодостаточный HTML
+func main() {
+	formatFlag := flag.String("format", "text", "формат: text, markdown или html")
+	output := flag.String("o", "", "файл для вывода (по умолчанию stdout)")
+	langFlag := flag.String("lang", "", "язык: ru или en (по умолчанию DEFAULT_LANG)")
+	flag.Parse()
+
+	format, err := timeline.ParseFormat(*formatFlag)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+	}
+	lang := cfg.Language
+	if *langFlag != "" {
+		lang = *langFlag
+	}
+
+	// Расписание только читается: журнал не нужен.
+	// Отчёт о загрузке уходит в stderr, чтобы в stdout была только таблица
+	cfg.JournalEnabled = false
+	if cfg.TrackerReport == "stdout" {
+		cfg.TrackerReport = "stderr"
+	}
+	trainTracker, err := tracker.NewTrainTrackerWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки расписания: %v", err)
+	}
+	defer trainTracker.Close()
+
+	out := os.Stdout
+	if *output != "" {
+		if out, err = os.Create(*output); err != nil {
+			log.Fatalf("❌ Ошибка создания файла: %v", err)
+		}
+	}
+
+	w := bufio.NewWriter(out)
+	err = timeline.Render(w, format, i18n.ParseOrDefault(lang), trainTracker.RouteData, trainTracker.Stations)
+	if err == nil {
+		err = w.Flush()
+	}
+	if *output != "" {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		log.Fatalf("❌ Ошибка вывода расписания: %v", err)
+	}
+
+	if *output != "" {
+		fmt.Fprintf(os.Stderr, "✅ Расписание (%s) сохранено в %s\n", format, *output)
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/term"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/dashboard"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/metrics"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// Управляющие последовательности терминала
+const (
+	enterScreen = "\x1b[?1049h\x1b[?25l" // Альтернативный экран, курсор скрыт
+	leaveScreen = "\x1b[?25h\x1b[?1049l"
+	clearScreen = "\x1b[H\x1b[2J"
+)
+
+// refreshInterval панель перерисовывается раз в секунду реального времени
+const refreshInterval = time.Second
+
+// Панель поезда в терминале для настенного экрана: обновляется каждую секунду,
+// клавишами можно перематывать время вперёд и назад
+func main() {
+	langFlag := flag.String("lang", "", "язык: ru или en (по умолчанию DEFAULT_LANG)")
+	atFlag := flag.String("at", "", "начальный момент в RFC3339 (по умолчанию CLOCK_START или текущее время)")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки конфигурации: %v", err)
+	}
+	requested := cfg.Language
+	if *langFlag != "" {
+		requested = *langFlag
+	}
+	lang := i18n.ParseOrDefault(requested)
+
+	if *atFlag != "" {
+		if cfg.ClockStart, err = time.Parse(time.RFC3339, *atFlag); err != nil {
+			log.Fatalf("❌ --at: ожидается RFC3339: %v", err)
+		}
+	}
+
+	stdin, stdout := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+	if !term.IsTerminal(stdin) || !term.IsTerminal(stdout) {
+		log.Fatal(i18n.T(lang, "tui.no_terminal"))
+	}
+
+	// Панель запрашивает позицию каждую секунду: в журнал поездки это не пишем
+	cfg.JournalEnabled = false
+	trainTracker, err := tracker.NewTrainTrackerWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("❌ Ошибка загрузки расписания: %v", err)
+	}
+	defer trainTracker.Close()
+
+	handler := api.NewQuestionHandlerWithConfig(trainTracker, cfg, metrics.NewMetricsCollector())
+	defer handler.Close()
+
+	if len(trainTracker.Stations) == 0 {
+		log.Fatal("❌ В маршруте нет станций")
+	}
+	arrival := trainTracker.Stations[len(trainTracker.Stations)-1].ArrivalTime
+	viewer := dashboard.NewViewer(trainTracker.Clock, trainTracker.Milestones(trainTracker.RouteData.StartTime, arrival))
+
+	state, err := term.MakeRaw(stdin)
+	if err != nil {
+		log.Fatalf("❌ Не удалось переключить терминал: %v", err)
+	}
+	fmt.Print(enterScreen)
+	defer func() {
+		fmt.Print(leaveScreen)
+		term.Restore(stdin, state)
+	}()
+
+	keys := make(chan []byte)
+	go readInput(keys)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGWINCH)
+	defer signal.Stop(signals)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		draw(handler, viewer, stdout, lang)
+
+		select {
+		case input, ok := <-keys:
+			if !ok {
+				return
+			}
+			for _, key := range dashboard.ParseKeys(input) {
+				if viewer.Handle(key) {
+					return
+				}
+			}
+		case sig := <-signals:
+			if sig != syscall.SIGWINCH {
+				return
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// draw перерисовывает экран целиком. В сыром режиме перевод строки
+// не возвращает каретку, поэтому строки разделяются \r\n
+func draw(h *api.QuestionHandler, v *dashboard.Viewer, fd int, lang i18n.Lang) {
+	width, _, err := term.GetSize(fd)
+	if err != nil {
+		width = 80
+	}
+	frame := dashboard.Capture(h, v, lang)
+	fmt.Print(clearScreen + strings.Join(dashboard.Render(frame, width, lang), "\r\n"))
+}
+
+// readInput читает нажатия клавиш; канал закрывается при ошибке чтения
+func readInput(keys chan<- []byte) {
+	defer close(keys)
+
+	buf := make([]byte, 64)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return
+		}
+		input := make([]byte, n)
+		copy(input, buf[:n])
+		keys <- input
+	}
+}
@@ -2,4 +2,18 @@ module reyna-train-tracker
 
 go 1.25.2
 
-require github.com/caarlos0/env/v9 v9.0.0
+require (
+	github.com/caarlos0/env/v9 v9.0.0
+	github.com/graphql-go/graphql v0.8.1
+	go.etcd.io/bbolt v1.5.0
+	golang.org/x/term v0.45.0
+	google.golang.org/grpc v1.84.0
+	google.golang.org/protobuf v1.36.11
+)
+
+require (
+	golang.org/x/net v0.57.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260706201446-f0a921348800 // indirect
+)
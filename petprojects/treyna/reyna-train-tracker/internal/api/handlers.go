@@ -1,12 +1,17 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"reyna-train-tracker/internal/clock"
 	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/i18n"
 	"reyna-train-tracker/internal/metrics"
 	"reyna-train-tracker/internal/models"
 	"reyna-train-tracker/internal/tracker"
@@ -21,66 +26,194 @@ type QuestionHandler struct {
 	Semaphore    *Semaphore
 	RateLimiter  *RateLimiter
 	LoadBalancer *LoadBalancer
+	Pool         *WorkerPool
+	Questions    *QuestionRegistry
+	Language     i18n.Lang   // Язык ответов, если запрос не указал свой
+	Clock        clock.Clock // Часы трекера: по ним лимитер и отметки ProcessedAt
+
+	lifecycleMu sync.Mutex
+	closed      bool           // После Shutdown новые пакеты вопросов не принимаются
+	batches     sync.WaitGroup // Пакеты вопросов, которые сейчас обрабатываются
 }
 
 // NewQuestionHandlerWithConfig создаёт новый обработчик вопросов с конфигурацией
+// Стратегия балансировки, веса воркеров и размер очереди пула берутся из конфигурации
 func NewQuestionHandlerWithConfig(t *tracker.TrainTracker, cfg *config.Config, metrics *metrics.MetricsCollector) *QuestionHandler {
-	return &QuestionHandler{
+	strategy, err := ParseStrategy(cfg.LoadBalancerStrategy)
+	if err != nil {
+		fmt.Printf("⚠️  %v, используется %s\n", err, StrategyRoundRobin)
+		strategy = StrategyRoundRobin
+	}
+
+	loadBalancer := NewLoadBalancerWithStrategy(cfg.NumWorkers, strategy)
+	for i, weight := range cfg.WorkerWeights {
+		loadBalancer.SetWeight(i+1, weight)
+	}
+
+	h := &QuestionHandler{
 		Tracker:        t,
 		Config:         cfg,
 		Metrics:        metrics,
 		Semaphore:      NewSemaphore(cfg.MaxConcurrentRequests),
-		RateLimiter:    NewRateLimiter(cfg.RateLimitPerSecond, 1*time.Second),
-		LoadBalancer:   NewLoadBalancer(cfg.NumWorkers),
+		RateLimiter:    NewRateLimiterWithClock(cfg.RateLimitPerSecond, 1*time.Second, t.Clock),
+		LoadBalancer:   loadBalancer,
+		Pool:           NewWorkerPool(loadBalancer, cfg.QueueSize, cfg.QueueTimeout),
+		Questions:      NewQuestionRegistry(),
+		Language:       i18n.ParseOrDefault(cfg.Language),
+		Clock:          t.Clock,
 	}
+	RegisterBuiltinQuestions(h.Questions, h)
+	RegisterExtraQuestions(h.Questions)
+
+	return h
 }
 
-// ProcessAllQuestions обрабатывает все 10 вопросов параллельно
-// Использует паттерны: Fan-out, Fan-in, WaitGroup
+// ProcessAllQuestions обрабатывает все зарегистрированные вопросы параллельно
+// Использует паттерны: Fan-out, Fan-in, Worker Pool
+// После Shutdown возвращает nil
 func (h *QuestionHandler) ProcessAllQuestions(currentTime time.Time) []models.QuestionResult {
-	// Fan-out: запускаем обработку всех вопросов параллельно
-	results := make(chan models.QuestionResult, 10)
-	var wg sync.WaitGroup
+	return h.ProcessAllQuestionsIn(currentTime, h.Language)
+}
+
+// ProcessAllQuestionsIn как ProcessAllQuestions, но отвечает на языке lang
+func (h *QuestionHandler) ProcessAllQuestionsIn(currentTime time.Time, lang i18n.Lang) []models.QuestionResult {
+	return h.ProcessQuestionsIn(currentTime, lang, nil)
+}
+
+// ProcessQuestionsIn как ProcessAllQuestionsIn, но только для вопросов numbers
+// (пустой список - все вопросы реестра). Неизвестный номер получает ответ с ошибкой
+func (h *QuestionHandler) ProcessQuestionsIn(currentTime time.Time, lang i18n.Lang, numbers []int) []models.QuestionResult {
+	if !h.beginBatch() {
+		return nil
+	}
+	defer h.batches.Done()
 
 	// Получаем текущую позицию один раз для всех вопросов
 	position := h.Tracker.GetCurrentPosition(currentTime)
 
-	// Запускаем горутины для каждого вопроса (Fan-out)
-	for i := 1; i <= 10; i++ {
-		wg.Add(1)
-		go func(questionNum int) {
-			defer wg.Done()
+	process := func(questionNum, workerID int) models.QuestionResult {
+		return h.processQuestion(questionNum, currentTime, position, workerID, lang)
+	}
+	if len(numbers) == 0 {
+		return h.dispatchQuestions(process)
+	}
+
+	return h.dispatchQuestionNumbers(numbers, process)
+}
+
+// dispatchQuestions ставит все вопросы реестра в очередь пула воркеров (Fan-out)
+// и собирает ответы (Fan-in) по порядку номеров. Вопрос, который пул не принял
+// из-за переполнения очереди, получает ответ с ошибкой
+func (h *QuestionHandler) dispatchQuestions(process func(questionNum, workerID int) models.QuestionResult) []models.QuestionResult {
+	questions := h.Questions.All()
+	numbers := make([]int, 0, len(questions))
+	for _, question := range questions {
+		numbers = append(numbers, question.ID())
+	}
+	return h.dispatchQuestionNumbers(numbers, process)
+}
+
+// dispatchQuestionNumbers как dispatchQuestions, но для заданных номеров вопросов
+func (h *QuestionHandler) dispatchQuestionNumbers(numbers []int, process func(questionNum, workerID int) models.QuestionResult) []models.QuestionResult {
+	results := make(chan models.QuestionResult, len(numbers))
 
+	for _, questionNum := range numbers {
+		err := h.Pool.Submit(context.Background(), func(workerID int) bool {
 			// Применяем rate limiter
-			h.RateLimiter.Wait()
+			if err := h.RateLimiter.WaitContext(context.Background()); err != nil {
+				results <- h.rejectedResult(questionNum, err)
+				return false
+			}
 
 			// Применяем semaphore
 			h.Semaphore.Acquire()
 			defer h.Semaphore.Release()
 
-			// Получаем воркера из load balancer
-			worker := h.LoadBalancer.GetNextWorker()
-			defer h.LoadBalancer.ReleaseWorker(worker)
-
-			// Обрабатываем вопрос
-			result := h.processQuestion(questionNum, currentTime, position, worker.ID)
+			result := process(questionNum, workerID)
 			results <- result
-		}(i)
+			return isSuccessful(result)
+		})
+		if err != nil {
+			results <- h.rejectedResult(questionNum, err)
+		}
+	}
+
+	// Fan-in: на каждый вопрос приходит ровно один ответ
+	allResults := make([]models.QuestionResult, 0, len(numbers))
+	for range numbers {
+		allResults = append(allResults, <-results)
 	}
 
-	// Ждём завершения всех горутин
+	// Ответы приходят в порядке готовности; клиентам отдаём по номеру вопроса
+	sort.Slice(allResults, func(i, j int) bool {
+		return allResults[i].QuestionNumber < allResults[j].QuestionNumber
+	})
+	return allResults
+}
+
+// beginBatch регистрирует новый пакет вопросов, если обработчик ещё не остановлен
+func (h *QuestionHandler) beginBatch() bool {
+	h.lifecycleMu.Lock()
+	defer h.lifecycleMu.Unlock()
+
+	if h.closed {
+		return false
+	}
+	h.batches.Add(1)
+	return true
+}
+
+// Shutdown перестаёт принимать новые пакеты вопросов, дожидается
+// обработки текущих и останавливает rate limiter и пул воркеров.
+// Если контекст истёк раньше, лимитер всё равно останавливается,
+// а незавершённые вопросы получают ответ с ошибкой
+func (h *QuestionHandler) Shutdown(ctx context.Context) error {
+	h.lifecycleMu.Lock()
+	h.closed = true
+	h.lifecycleMu.Unlock()
+
+	drained := make(chan struct{})
 	go func() {
-		wg.Wait()
-		close(results)
+		h.batches.Wait()
+		close(drained)
 	}()
 
-	// Fan-in: собираем результаты из канала
-	allResults := make([]models.QuestionResult, 0, 10)
-	for result := range results {
-		allResults = append(allResults, result)
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = ctx.Err()
 	}
 
-	return allResults
+	h.RateLimiter.Close()
+	h.Pool.Close()
+	return err
+}
+
+// Close останавливает обработчик, дожидаясь всех текущих пакетов
+func (h *QuestionHandler) Close() error {
+	return h.Shutdown(context.Background())
+}
+
+// ErrNoActiveWorkers все воркеры выключены или выводятся из работы
+var ErrNoActiveWorkers = errors.New("no active workers")
+
+// isSuccessful проверяет, что в ответе нет ошибки
+func isSuccessful(result models.QuestionResult) bool {
+	if answerMap, ok := result.Answer.(map[string]interface{}); ok {
+		_, hasError := answerMap["error"]
+		return !hasError
+	}
+	return result.Answer != nil
+}
+
+// rejectedResult формирует ответ для вопроса, который не удалось взять в работу
+func (h *QuestionHandler) rejectedResult(questionNum int, err error) models.QuestionResult {
+	return models.QuestionResult{
+		QuestionNumber: questionNum,
+		Answer:         map[string]interface{}{"error": err.Error()},
+		ProcessedAt:    h.Clock.Now(),
+	}
 }
 
 // processQuestion обрабатывает конкретный вопрос
@@ -89,47 +222,30 @@ func (h *QuestionHandler) processQuestion(
 	currentTime time.Time,
 	position *models.CurrentPosition,
 	workerID int,
+	lang i18n.Lang,
 ) models.QuestionResult {
 	h.Tracker.IncrementQuestionCounter(questionNum)
 
 	result := models.QuestionResult{
 		QuestionNumber: questionNum,
-		ProcessedAt:    time.Now(),
-	}
-
-	switch questionNum {
-	case 1:
-		result.QuestionText = "Какое сейчас локальное время у пассажира?"
-		result.Answer = h.Question1_LocalTime(currentTime, position)
-	case 2:
-		result.QuestionText = "На какой станции пассажир сейчас находится?"
-		result.Answer = h.Question2_CurrentStation(position)
-	case 3:
-		result.QuestionText = "Поезд стоит или в пути?"
-		result.Answer = h.Question3_TrainStatus(currentTime, position)
-	case 4:
-		result.QuestionText = "Какой день путешествия?"
-		result.Answer = h.Question4_JourneyDay(currentTime)
-	case 5:
-		result.QuestionText = "Какое расстояние от Москвы?"
-		result.Answer = h.Question5_Distance(position)
-	case 6:
-		result.QuestionText = "Когда пассажир прибудет на следующую станцию?"
-		result.Answer = h.Question6_NextArrival(position)
-	case 7:
-		result.QuestionText = "Какая разница во времени между Москвой и текущим городом?"
-		result.Answer = h.Question7_TimeDifference(currentTime, position)
-	case 8:
-		result.QuestionText = "Если я пишу сейчас, когда она получит?"
-		result.Answer = h.Question8_MessageToHer(currentTime, position)
-	case 9:
-		result.QuestionText = "Если она пишет сейчас, когда я получу?"
-		result.Answer = h.Question9_MessageFromHer(currentTime, position)
-	case 10:
-		result.QuestionText = "Какие основные станции впереди и когда прибытие?"
-		result.Answer = h.Question10_UpcomingStations(position)
+		ProcessedAt:    h.Clock.Now(),
+	}
+
+	question, ok := h.Questions.Get(questionNum)
+	if !ok {
+		result.Answer = map[string]interface{}{"error": fmt.Sprintf("unknown question %d", questionNum)}
+		return result
 	}
 
+	result.QuestionText = question.Text(lang)
+	result.Answer = question.Answer(QuestionContext{
+		Tracker:     h.Tracker,
+		CurrentTime: currentTime,
+		Position:    position,
+		WorkerID:    workerID,
+		Lang:        lang,
+	})
+
 	return result
 }
 
@@ -148,14 +264,14 @@ func (h *QuestionHandler) Question1_LocalTime(currentTime time.Time, pos *models
 }
 
 // Question2_CurrentStation - На какой станции пассажир сейчас находится?
-func (h *QuestionHandler) Question2_CurrentStation(pos *models.CurrentPosition) map[string]interface{} {
+func (h *QuestionHandler) Question2_CurrentStation(pos *models.CurrentPosition, lang i18n.Lang) map[string]interface{} {
 	if pos == nil {
 		return map[string]interface{}{"error": "Position not found"}
 	}
 
 	if pos.IsAtStation && pos.CurrentStation != nil {
 		return map[string]interface{}{
-			"station":          pos.CurrentStation.Name,
+			"station":          i18n.StationName(lang, pos.CurrentStation.Name),
 			"distance_from_moscow": pos.CurrentStation.DistanceFromStart,
 			"at_station":       true,
 		}
@@ -163,53 +279,89 @@ func (h *QuestionHandler) Question2_CurrentStation(pos *models.CurrentPosition)
 
 	return map[string]interface{}{
 		"between_stations": true,
-		"previous":         pos.PreviousStation.Name,
-		"next":             pos.NextStation.Name,
+		"previous":         i18n.StationName(lang, pos.PreviousStation.Name),
+		"next":             i18n.StationName(lang, pos.NextStation.Name),
 		"distance_from_moscow": int(pos.DistanceFromStart),
 	}
 }
 
 // Question3_TrainStatus - Поезд стоит или в пути?
-func (h *QuestionHandler) Question3_TrainStatus(currentTime time.Time, pos *models.CurrentPosition) map[string]interface{} {
+func (h *QuestionHandler) Question3_TrainStatus(currentTime time.Time, pos *models.CurrentPosition, lang i18n.Lang) map[string]interface{} {
 	status := h.Tracker.GetTrainStatus(currentTime, pos)
 
 	if !status.IsMoving {
 		return map[string]interface{}{
-			"status":            "СТОИТ",
-			"station":           pos.CurrentStation.Name,
-			"stand_duration":    utils.FormatDuration(pos.CurrentStation.StandDuration),
-			"remaining_stand":   utils.FormatDuration(status.RemainingStand),
+			"status":            i18n.T(lang, "answer.status_standing"),
+			"station":           i18n.StationName(lang, pos.CurrentStation.Name),
+			"stand_duration":    i18n.FormatDuration(lang, pos.CurrentStation.StandDuration),
+			"remaining_stand":   i18n.FormatDuration(lang, status.RemainingStand),
 		}
 	}
 
 	return map[string]interface{}{
-		"status":         "В ПУТИ",
-		"from":           pos.PreviousStation.Name,
-		"to":             pos.NextStation.Name,
-		"time_to_next":   utils.FormatDuration(status.TimeToNext),
+		"status":         i18n.T(lang, "answer.status_moving"),
+		"from":           i18n.StationName(lang, pos.PreviousStation.Name),
+		"to":             i18n.StationName(lang, pos.NextStation.Name),
+		"time_to_next":   i18n.FormatDuration(lang, status.TimeToNext),
 	}
 }
 
 // Question4_JourneyDay - Какой день путешествия?
-func (h *QuestionHandler) Question4_JourneyDay(currentTime time.Time) map[string]interface{} {
+func (h *QuestionHandler) Question4_JourneyDay(currentTime time.Time, lang i18n.Lang) map[string]interface{} {
 	info := h.Tracker.GetJourneyInfo(currentTime)
 
-	return map[string]interface{}{
+	answer := map[string]interface{}{
 		"day_number":       info.DayNumber,
 		"start_date":       info.StartDate.Format("15:04 02.01.2006"),
-		"time_in_trip":     utils.FormatDuration(info.TotalTimeInTrip),
+		"time_in_trip":     i18n.FormatDuration(lang, info.TotalTimeInTrip),
+	}
+	if info.CalendarDay == 0 {
+		return answer
+	}
+
+	answer["calendar_day"] = info.CalendarDay
+	answer["local_date"] = info.LocalDate.Format("02.01.2006")
+	answer["timezone"] = info.Timezone
+
+	changes := make([]map[string]interface{}, 0, len(info.DateChanges))
+	for _, change := range info.DateChanges {
+		changes = append(changes, map[string]interface{}{
+			"date":        change.NewDate.Format("02.01.2006"),
+			"in":          i18n.FormatDuration(lang, change.In),
+			"timezone":    change.Timezone,
+			"station":     i18n.StationName(lang, change.Station),
+			"clock_shift": change.ClockShift,
+		})
+	}
+	answer["date_changes"] = changes
+	if len(info.DateChanges) > 0 {
+		answer["next_date_change"] = describeDateChange(info.DateChanges[0], lang)
+	}
+
+	return answer
+}
+
+// describeDateChange описывает смену даты словами: "08.10.2025 наступит через 3 часа ..."
+func describeDateChange(change models.DateChange, lang i18n.Lang) string {
+	key := "answer.date_change"
+	if change.ClockShift {
+		key = "answer.date_change_shift"
 	}
+	return i18n.T(lang, key,
+		change.NewDate.Format("02.01.2006"),
+		i18n.FormatDuration(lang, change.In),
+		i18n.StationName(lang, change.Station))
 }
 
 // Question5_Distance - Какое расстояние от Москвы?
-func (h *QuestionHandler) Question5_Distance(pos *models.CurrentPosition) map[string]interface{} {
+func (h *QuestionHandler) Question5_Distance(pos *models.CurrentPosition, lang i18n.Lang) map[string]interface{} {
 	if pos == nil {
 		return map[string]interface{}{"error": "Position not found"}
 	}
 
-	location := "между станциями"
+	location := i18n.T(lang, "answer.between_stations")
 	if pos.IsAtStation && pos.CurrentStation != nil {
-		location = pos.CurrentStation.Name
+		location = i18n.StationName(lang, pos.CurrentStation.Name)
 	}
 
 	return map[string]interface{}{
@@ -232,27 +384,39 @@ func (h *QuestionHandler) Question5_Distance(pos *models.CurrentPosition) map[st
 // 		"time_remaining":  utils.FormatDuration(timeToNext),
 // 	}
 // }
-func (h *QuestionHandler) Question6_NextArrival(pos *models.CurrentPosition) map[string]interface{} {
-    if pos == nil || pos.NextStation == nil {
-        return map[string]interface{}{"error": "Next station not found"}
-    }
+func (h *QuestionHandler) Question6_NextArrival(currentTime time.Time, pos *models.CurrentPosition, lang i18n.Lang) map[string]interface{} {
+	if pos == nil || pos.NextStation == nil {
+		return map[string]interface{}{"error": "Next station not found"}
+	}
 
-    timeToNext := pos.NextStation.ArrivalTime.Sub(time.Now())
-    
-    // Handle negative time (train is late or algorithm issue)
-    if timeToNext < 0 {
-        timeToNext = 0
-    }
+	// Прогноз по модели скорости: расписание, поправленное на наблюдения из журнала
+	prediction, ok := h.Tracker.PredictArrival(pos.NextStation.ID, currentTime)
+	if !ok {
+		return map[string]interface{}{"error": "Next station not found"}
+	}
 
-    return map[string]interface{}{
-        "next_station":    pos.NextStation.Name,
-        "arrival_time":    pos.NextStation.ArrivalTime.Format("15:04 02.01.2006"),
-        "time_remaining":  utils.FormatDuration(timeToNext),
-    }
+	timeToNext := prediction.Expected.Sub(currentTime)
+	if timeToNext < 0 {
+		timeToNext = 0
+	}
+
+	answer := map[string]interface{}{
+		"next_station":   i18n.StationName(lang, pos.NextStation.Name),
+		"arrival_time":   prediction.Expected.Format("15:04 02.01.2006"),
+		"scheduled_time": prediction.Scheduled.Format("15:04 02.01.2006"),
+		"arrival_window": fmt.Sprintf("%s - %s", prediction.Earliest.Format("15:04"), prediction.Latest.Format("15:04")),
+		"confidence":     fmt.Sprintf("%.0f%%", prediction.Confidence*100),
+		"time_remaining": i18n.FormatDuration(lang, timeToNext),
+	}
+	if prediction.Delay.Abs() >= time.Minute {
+		answer["delay"] = i18n.FormatOffset(lang, prediction.Delay.Round(time.Minute))
+	}
+
+	return answer
 }
 
 // Question7_TimeDifference - Какая разница во времени между Москвой и текущим городом?
-func (h *QuestionHandler) Question7_TimeDifference(currentTime time.Time, pos *models.CurrentPosition) map[string]interface{} {
+func (h *QuestionHandler) Question7_TimeDifference(currentTime time.Time, pos *models.CurrentPosition, lang i18n.Lang) map[string]interface{} {
 	if pos == nil {
 		return map[string]interface{}{"error": "Position not found"}
 	}
@@ -260,24 +424,37 @@ func (h *QuestionHandler) Question7_TimeDifference(currentTime time.Time, pos *m
 	moscowTime, _ := utils.ConvertToTimezone(currentTime, "Europe/Moscow")
 	localTime, _ := utils.ConvertToTimezone(currentTime, pos.Timezone)
 
-	diff, _ := utils.GetTimezoneDifference("Europe/Moscow", pos.Timezone)
+	diff, _ := utils.GetTimezoneDifference("Europe/Moscow", pos.Timezone, currentTime)
 
-	direction := "впереди Москвы"
+	direction := i18n.T(lang, "answer.ahead_of_moscow")
 	if diff < 0 {
-		direction = "отстаёт от Москвы"
+		direction = i18n.T(lang, "answer.behind_moscow")
 		diff = -diff
 	}
 
-	return map[string]interface{}{
+	answer := map[string]interface{}{
 		"moscow_time":      moscowTime.Format("15:04"),
 		"local_time":       localTime.Format("15:04"),
-		"difference":       utils.FormatDuration(diff),
+		"difference":       i18n.FormatDuration(lang, diff),
 		"direction":        direction,
 	}
+
+	// Предупреждаем о скором переводе часов на границе поясов
+	if changes := h.Tracker.UpcomingTimezoneChanges(currentTime); len(changes) > 0 && changes[0].In <= clockChangeWarning {
+		next := changes[0]
+		answer["clock_change"] = i18n.T(lang, "answer.clock_change",
+			i18n.FormatOffset(lang, next.Shift), i18n.FormatDuration(lang, next.In))
+		answer["next_timezone"] = next.Border.To
+	}
+
+	return answer
 }
 
+// clockChangeWarning за сколько до границы поясов вопрос 7 предупреждает о переводе часов
+const clockChangeWarning = 3 * time.Hour
+
 // Question8_MessageToHer - Если я пишу сейчас, когда она получит?
-func (h *QuestionHandler) Question8_MessageToHer(currentTime time.Time, pos *models.CurrentPosition) map[string]interface{} {
+func (h *QuestionHandler) Question8_MessageToHer(currentTime time.Time, pos *models.CurrentPosition, lang i18n.Lang) map[string]interface{} {
 	if pos == nil {
 		return map[string]interface{}{"error": "Position not found"}
 	}
@@ -289,12 +466,12 @@ func (h *QuestionHandler) Question8_MessageToHer(currentTime time.Time, pos *mod
 		"send_time_moscow":   moscowTime.Format("15:04"),
 		"receive_time_local": herTime.Format("15:04"),
 		"instant_delivery":   true,
-		"note":               "Сообщение доставляется мгновенно!",
+		"note":               i18n.T(lang, "answer.instant_delivery"),
 	}
 }
 
 // Question9_MessageFromHer - Если она пишет сейчас, когда я получу?
-func (h *QuestionHandler) Question9_MessageFromHer(currentTime time.Time, pos *models.CurrentPosition) map[string]interface{} {
+func (h *QuestionHandler) Question9_MessageFromHer(currentTime time.Time, pos *models.CurrentPosition, lang i18n.Lang) map[string]interface{} {
 	if pos == nil {
 		return map[string]interface{}{"error": "Position not found"}
 	}
@@ -306,12 +483,12 @@ func (h *QuestionHandler) Question9_MessageFromHer(currentTime time.Time, pos *m
 		"send_time_local":     herTime.Format("15:04"),
 		"receive_time_moscow": moscowTime.Format("15:04"),
 		"instant_delivery":    true,
-		"note":                "Сообщение доставляется мгновенно!",
+		"note":                i18n.T(lang, "answer.instant_delivery"),
 	}
 }
 
 // Question10_UpcomingStations - Какие основные станции впереди и когда прибытие?
-func (h *QuestionHandler) Question10_UpcomingStations(pos *models.CurrentPosition) map[string]interface{} {
+func (h *QuestionHandler) Question10_UpcomingStations(pos *models.CurrentPosition, lang i18n.Lang) map[string]interface{} {
 	if pos == nil {
 		return map[string]interface{}{"error": "Position not found"}
 	}
@@ -332,9 +509,9 @@ func (h *QuestionHandler) Question10_UpcomingStations(pos *models.CurrentPositio
 		station := h.Tracker.Stations[i]
 		if station.IsMajor {
 			upcoming = append(upcoming, map[string]interface{}{
-				"name":          station.Name,
+				"name":          i18n.StationName(lang, station.Name),
 				"arrival_time":  station.ArrivalTime.Format("15:04 02.01.2006"),
-				"stand_duration": utils.FormatDuration(station.StandDuration),
+				"stand_duration": i18n.FormatDuration(lang, station.StandDuration),
 				"distance":      station.DistanceFromStart,
 			})
 			count++
@@ -370,5 +547,5 @@ func (h *QuestionHandler) ProcessAllQuestionsWithRetry(currentTime time.Time) []
     if h.Config != nil && h.Config.DebugMode {
         fmt.Println("🔄 Используется улучшенная обработка с повторными попытками...")
     }
-    return h.enhancedProcessAllQuestions(currentTime)
+    return h.enhancedProcessAllQuestions(currentTime, h.Language)
 }
\ No newline at end of file
@@ -2,8 +2,8 @@ package api
 
 import (
 	"fmt"
+	"reyna-train-tracker/internal/i18n"
 	"reyna-train-tracker/internal/models"
-	"sync"
 	"time"
 )
 
@@ -14,13 +14,14 @@ func (h *QuestionHandler) processQuestionWithRetry(
     position *models.CurrentPosition,
     workerID int,
     maxRetries int,
+    lang i18n.Lang,
 ) models.QuestionResult {
     var result models.QuestionResult
     var lastErr error
     
     for attempt := 0; attempt < maxRetries; attempt++ {
         startTime := time.Now()
-        result = h.processQuestion(questionNum, currentTime, position, workerID)
+        result = h.processQuestion(questionNum, currentTime, position, workerID, lang)
         processingTime := time.Since(startTime)
         
         // Записываем метрику
@@ -59,7 +60,7 @@ func (h *QuestionHandler) processQuestionWithRetry(
     // Если все попытки неудачны, возвращаем ошибку
     if lastErr != nil {
         result.Answer = map[string]interface{}{
-            "error": fmt.Sprintf("❌ Не удалось обработать вопрос после %d попыток: %v", maxRetries, lastErr),
+            "error": i18n.T(lang, "answer.retries_exhausted", maxRetries, lastErr),
             "question_number": questionNum,
             "max_retries": maxRetries,
         }
@@ -69,7 +70,12 @@ func (h *QuestionHandler) processQuestionWithRetry(
 }
 
 // enhancedProcessAllQuestions улучшенная версия обработки всех вопросов с retry логикой
-func (h *QuestionHandler) enhancedProcessAllQuestions(currentTime time.Time) []models.QuestionResult {
+func (h *QuestionHandler) enhancedProcessAllQuestions(currentTime time.Time, lang i18n.Lang) []models.QuestionResult {
+    if !h.beginBatch() {
+        return nil
+    }
+    defer h.batches.Done()
+
     // Получаем текущую позицию один раз для всех вопросов
     position := h.Tracker.GetCurrentPosition(currentTime)
     
@@ -79,45 +85,10 @@ func (h *QuestionHandler) enhancedProcessAllQuestions(currentTime time.Time) []m
         maxRetries = h.Config.MaxRetries
     }
 
-    results := make(chan models.QuestionResult, 10)
-    var wg sync.WaitGroup
-
-    // Fan-out: запускаем обработку всех вопросов параллельно с retry
-    for i := 1; i <= 10; i++ {
-        wg.Add(1)
-        go func(questionNum int) {
-            defer wg.Done()
-
-            // Применяем rate limiter
-            h.RateLimiter.Wait()
-
-            // Применяем semaphore
-            h.Semaphore.Acquire()
-            defer h.Semaphore.Release()
-
-            // Получаем воркера из load balancer
-            worker := h.LoadBalancer.GetNextWorker()
-            defer h.LoadBalancer.ReleaseWorker(worker)
-
-            // Обрабатываем вопрос с повторными попытками
-            result := h.processQuestionWithRetry(questionNum, currentTime, position, worker.ID, maxRetries)
-            results <- result
-        }(i)
-    }
-
-    // Ждём завершения всех горутин
-    go func() {
-        wg.Wait()
-        close(results)
-    }()
-
-    // Fan-in: собираем результаты из канала
-    allResults := make([]models.QuestionResult, 0, 10)
-    for result := range results {
-        allResults = append(allResults, result)
-    }
-
-    return allResults
+    // Обрабатываем вопросы в пуле воркеров с повторными попытками
+    return h.dispatchQuestions(func(questionNum, workerID int) models.QuestionResult {
+        return h.processQuestionWithRetry(questionNum, currentTime, position, workerID, maxRetries, lang)
+    })
 }
 
 // validateQuestionResult проверяет валидность результата вопроса
@@ -128,26 +99,9 @@ func (h *QuestionHandler) validateQuestionResult(result models.QuestionResult) b
             return false
         }
         
-        // Валидация в зависимости от типа вопроса
-        switch result.QuestionNumber {
-        case 1: // Локальное время
-            return answerMap["local_time"] != nil && answerMap["timezone"] != nil
-        case 2: // Текущая станция
-            return answerMap["distance_from_moscow"] != nil
-        case 3: // Статус поезда
-            return answerMap["status"] != nil
-        case 4: // День путешествия
-            return answerMap["day_number"] != nil
-        case 5: // Расстояние
-            return answerMap["distance_km"] != nil
-        case 6: // Следующая станция
-            return answerMap["next_station"] != nil && answerMap["arrival_time"] != nil
-        case 7: // Разница во времени
-            return answerMap["difference"] != nil
-        case 8, 9: // Сообщения
-            return answerMap["send_time_moscow"] != nil || answerMap["send_time_local"] != nil
-        case 10: // Основные станции
-            return answerMap["upcoming_stations"] != nil
+        // Валидация по правилам самого вопроса
+        if question, ok := h.Questions.Get(result.QuestionNumber); ok {
+            return question.Validate(answerMap)
         }
     }
     return false
@@ -0,0 +1,87 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/cache"
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/metrics"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+var departureDay = time.Date(2025, 10, 12, 20, 30, 0, 0, time.FixedZone("MSK", 3*3600))
+
+// waitGoroutines ждёт, пока число горутин не вернётся к want
+func waitGoroutines(t *testing.T, want int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > want && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := runtime.NumGoroutine(); got > want {
+		t.Errorf("goroutines leaked: %d running, expected at most %d", got, want)
+	}
+}
+
+func TestCloseStopsGoroutines(t *testing.T) {
+	tests := []struct {
+		name  string
+		start func(t *testing.T) (closeFn func())
+	}{
+		{"rate limiter", func(t *testing.T) func() {
+			return api.NewRateLimiter(10, time.Millisecond).Close
+		}},
+		{"cache", func(t *testing.T) func() {
+			return cache.NewInMemoryCache[int]().Close
+		}},
+		{"sharded cache", func(t *testing.T) func() {
+			return cache.NewShardedCache(cache.ShardedOptions[int]{Shards: 8}).Close
+		}},
+		{"tracker and handler", func(t *testing.T) func() {
+			cfg := trackertest.Config()
+			trainTracker, err := tracker.NewTrainTrackerWithClock(cfg, clock.NewFake(departureDay))
+			if err != nil {
+				t.Fatal(err)
+			}
+			handler := api.NewQuestionHandlerWithConfig(trainTracker, cfg, metrics.NewMetricsCollector())
+			if len(handler.ProcessAllQuestions(departureDay)) == 0 {
+				t.Error("no answers before shutdown")
+			}
+			return func() {
+				handler.Close()
+				trainTracker.Close()
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+			closeFn := tt.start(t)
+			closeFn()
+			closeFn() // Повторный Close безопасен
+			waitGoroutines(t, before)
+		})
+	}
+}
+
+func TestShutdownRejectsNewWork(t *testing.T) {
+	_, handler := trackertest.New(t, departureDay)
+	if err := handler.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if results := handler.ProcessAllQuestions(departureDay); results != nil {
+		t.Errorf("expected no answers after shutdown, got %d", len(results))
+	}
+
+	limiter := api.NewRateLimiterWithClock(0, time.Hour, clock.NewFake(departureDay))
+	limiter.Close()
+	if err := limiter.WaitContext(context.Background()); !errors.Is(err, api.ErrRateLimiterClosed) {
+		t.Errorf("expected ErrRateLimiterClosed, got %v", err)
+	}
+}
@@ -1,46 +1,128 @@
 package api
 
 import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 )
 
+// Strategy стратегия выбора воркера
+type Strategy string
+
+const (
+	StrategyRoundRobin  Strategy = "round-robin"  // По кругу
+	StrategyLeastLoaded Strategy = "least-loaded" // Воркер с наименьшей нагрузкой
+	StrategyWeighted    Strategy = "weighted"     // Smooth weighted round-robin (как в nginx)
+	StrategyPowerOfTwo  Strategy = "p2c"          // Power of two choices: лучший из двух случайных
+)
+
+// ParseStrategy разбирает название стратегии из конфигурации
+func ParseStrategy(name string) (Strategy, error) {
+	switch Strategy(strings.ToLower(strings.TrimSpace(name))) {
+	case StrategyRoundRobin, "":
+		return StrategyRoundRobin, nil
+	case StrategyLeastLoaded:
+		return StrategyLeastLoaded, nil
+	case StrategyWeighted:
+		return StrategyWeighted, nil
+	case StrategyPowerOfTwo, "power-of-two":
+		return StrategyPowerOfTwo, nil
+	default:
+		return "", fmt.Errorf("unknown load balancing strategy: %s", name)
+	}
+}
+
 // Worker представляет обработчика запросов
 type Worker struct {
 	ID       int
 	Load     atomic.Uint64 // Текущая нагрузка на воркера
-	IsActive bool
+	active   atomic.Bool   // Воркер принимает задачи
+	draining atomic.Bool   // Воркер доделывает текущие задачи и новых не получает
+	weight   atomic.Int64  // Вес для взвешенной стратегии
+
+	successes atomic.Uint64
+	failures  atomic.Uint64
+
+	currentWeight int64 // Текущий вес smooth weighted round-robin (под LoadBalancer.mu)
+}
+
+// IsActive сообщает, можно ли отдавать воркеру новые задачи
+func (w *Worker) IsActive() bool {
+	return w.active.Load() && !w.draining.Load()
+}
+
+// Weight возвращает вес воркера
+func (w *Worker) Weight() int {
+	return int(w.weight.Load())
 }
 
 // LoadBalancer распределяет нагрузку между воркерами
-// Паттерн: Round-robin Load Balancer с отслеживанием нагрузки
+// Паттерн: Load Balancer с выбираемой стратегией и отслеживанием нагрузки
 type LoadBalancer struct {
-	workers []*Worker
-	next    atomic.Uint64 // Индекс следующего воркера (для round-robin)
+	workers  []*Worker
+	next     atomic.Uint64 // Индекс следующего воркера (для round-robin)
+	strategy Strategy
+	mu       sync.Mutex // Защищает состояние взвешенной стратегии
 }
 
-// NewLoadBalancer создаёт новый load balancer
+// NewLoadBalancer создаёт новый load balancer (round-robin)
 func NewLoadBalancer(numWorkers int) *LoadBalancer {
+	return NewLoadBalancerWithStrategy(numWorkers, StrategyRoundRobin)
+}
+
+// NewLoadBalancerWithStrategy создаёт load balancer с заданной стратегией
+func NewLoadBalancerWithStrategy(numWorkers int, strategy Strategy) *LoadBalancer {
 	lb := &LoadBalancer{
-		workers: make([]*Worker, numWorkers),
+		workers:  make([]*Worker, numWorkers),
+		strategy: strategy,
 	}
 
 	for i := 0; i < numWorkers; i++ {
-		lb.workers[i] = &Worker{
-			ID:       i + 1,
-			IsActive: true,
-		}
+		worker := &Worker{ID: i + 1}
+		worker.active.Store(true)
+		worker.weight.Store(1)
+		lb.workers[i] = worker
 	}
 
 	return lb
 }
 
-// GetNextWorker возвращает следующего воркера (round-robin)
+// Strategy возвращает текущую стратегию
+func (lb *LoadBalancer) Strategy() Strategy {
+	return lb.strategy
+}
+
+// GetWorker выбирает воркера согласно стратегии.
+// Возвращает nil, если активных воркеров нет
+func (lb *LoadBalancer) GetWorker() *Worker {
+	switch lb.strategy {
+	case StrategyLeastLoaded:
+		return lb.GetLeastLoadedWorker()
+	case StrategyWeighted:
+		return lb.GetWeightedWorker()
+	case StrategyPowerOfTwo:
+		return lb.GetPowerOfTwoWorker()
+	default:
+		return lb.GetNextWorker()
+	}
+}
+
+// GetNextWorker возвращает следующего активного воркера (round-robin)
 func (lb *LoadBalancer) GetNextWorker() *Worker {
-	n := lb.next.Add(1)
-	index := int((n - 1) % uint64(len(lb.workers)))
-	worker := lb.workers[index]
-	worker.Load.Add(1)
-	return worker
+	for range lb.workers {
+		n := lb.next.Add(1)
+		index := int((n - 1) % uint64(len(lb.workers)))
+		worker := lb.workers[index]
+		if worker.IsActive() {
+			worker.Load.Add(1)
+			return worker
+		}
+	}
+	return nil
 }
 
 // GetLeastLoadedWorker возвращает воркера с наименьшей нагрузкой
@@ -49,7 +131,7 @@ func (lb *LoadBalancer) GetLeastLoadedWorker() *Worker {
 	minLoad := ^uint64(0) // Максимальное значение uint64
 
 	for _, worker := range lb.workers {
-		if worker.IsActive {
+		if worker.IsActive() {
 			load := worker.Load.Load()
 			if load < minLoad {
 				minLoad = load
@@ -65,6 +147,62 @@ func (lb *LoadBalancer) GetLeastLoadedWorker() *Worker {
 	return leastLoaded
 }
 
+// GetWeightedWorker выбирает воркера по весам (smooth weighted round-robin):
+// воркер с весом 3 получает втрое больше задач, чем с весом 1,
+// и задачи распределяются равномерно, а не пачками
+func (lb *LoadBalancer) GetWeightedWorker() *Worker {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+
+	var best *Worker
+	var total int64
+	for _, worker := range lb.workers {
+		if !worker.IsActive() {
+			continue
+		}
+		weight := worker.weight.Load()
+		worker.currentWeight += weight
+		total += weight
+		if best == nil || worker.currentWeight > best.currentWeight {
+			best = worker
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	best.currentWeight -= total
+	best.Load.Add(1)
+	return best
+}
+
+// GetPowerOfTwoWorker берёт двух случайных активных воркеров
+// и отдаёт задачу менее нагруженному (power of two choices)
+func (lb *LoadBalancer) GetPowerOfTwoWorker() *Worker {
+	active := make([]*Worker, 0, len(lb.workers))
+	for _, worker := range lb.workers {
+		if worker.IsActive() {
+			active = append(active, worker)
+		}
+	}
+
+	if len(active) == 0 {
+		return nil
+	}
+
+	chosen := active[rand.IntN(len(active))]
+	if len(active) > 1 {
+		other := active[rand.IntN(len(active))]
+		if other.Load.Load() < chosen.Load.Load() {
+			chosen = other
+		}
+	}
+
+	chosen.Load.Add(1)
+	return chosen
+}
+
 // ReleaseWorker освобождает воркера после выполнения задачи
 func (lb *LoadBalancer) ReleaseWorker(worker *Worker) {
 	if worker.Load.Load() > 0 {
@@ -72,18 +210,97 @@ func (lb *LoadBalancer) ReleaseWorker(worker *Worker) {
 	}
 }
 
+// Complete освобождает воркера и учитывает результат задачи
+func (lb *LoadBalancer) Complete(worker *Worker, success bool) {
+	if success {
+		worker.successes.Add(1)
+	} else {
+		worker.failures.Add(1)
+	}
+	lb.ReleaseWorker(worker)
+}
+
+// worker находит воркера по ID
+func (lb *LoadBalancer) worker(id int) (*Worker, error) {
+	for _, worker := range lb.workers {
+		if worker.ID == id {
+			return worker, nil
+		}
+	}
+	return nil, fmt.Errorf("worker %d not found", id)
+}
+
+// Activate включает воркера (в том числе после draining)
+func (lb *LoadBalancer) Activate(id int) error {
+	worker, err := lb.worker(id)
+	if err != nil {
+		return err
+	}
+	worker.draining.Store(false)
+	worker.active.Store(true)
+	return nil
+}
+
+// Deactivate сразу выключает воркера; текущие задачи он доделает
+func (lb *LoadBalancer) Deactivate(id int) error {
+	worker, err := lb.worker(id)
+	if err != nil {
+		return err
+	}
+	worker.active.Store(false)
+	return nil
+}
+
+// Drain перестаёт отдавать воркеру новые задачи и ждёт, пока он
+// закончит текущие. После этого воркер выключается
+func (lb *LoadBalancer) Drain(ctx context.Context, id int) error {
+	worker, err := lb.worker(id)
+	if err != nil {
+		return err
+	}
+	worker.draining.Store(true)
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for worker.Load.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	worker.active.Store(false)
+	worker.draining.Store(false)
+	return nil
+}
+
+// SetWeight задаёт вес воркера для взвешенной стратегии (минимум 1)
+func (lb *LoadBalancer) SetWeight(id int, weight int) error {
+	worker, err := lb.worker(id)
+	if err != nil {
+		return err
+	}
+	worker.weight.Store(int64(max(weight, 1)))
+	return nil
+}
+
 // GetWorkerStats возвращает статистику по воркерам
 func (lb *LoadBalancer) GetWorkerStats() []map[string]interface{} {
 	stats := make([]map[string]interface{}, len(lb.workers))
 
 	for i, worker := range lb.workers {
 		stats[i] = map[string]interface{}{
-			"id":     worker.ID,
-			"load":   worker.Load.Load(),
-			"active": worker.IsActive,
+			"id":        worker.ID,
+			"load":      worker.Load.Load(),
+			"active":    worker.active.Load(),
+			"draining":  worker.draining.Load(),
+			"weight":    worker.Weight(),
+			"successes": worker.successes.Load(),
+			"failures":  worker.failures.Load(),
 		}
 	}
 
 	return stats
 }
-
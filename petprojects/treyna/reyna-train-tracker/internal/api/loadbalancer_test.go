@@ -0,0 +1,93 @@
+package api
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseStrategy(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Strategy
+		wantErr bool
+	}{
+		{"", StrategyRoundRobin, false},
+		{"Round-Robin", StrategyRoundRobin, false},
+		{" least-loaded ", StrategyLeastLoaded, false},
+		{"weighted", StrategyWeighted, false},
+		{"power-of-two", StrategyPowerOfTwo, false},
+		{"random", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseStrategy(tt.name)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseStrategy(%q) = %q, %v", tt.name, got, err)
+		}
+	}
+}
+
+// TestStrategyOrder какие воркеры получают задачи при каждой стратегии
+func TestStrategyOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy Strategy
+		weights  []int
+		inactive []int // Выключенные воркеры
+		loaded   []int // Воркеры, которые уже заняты задачей
+		release  bool  // Освобождать воркера сразу после выбора
+		picks    int
+		want     []int
+	}{
+		{name: "round-robin", strategy: StrategyRoundRobin, release: true, picks: 6, want: []int{1, 2, 3, 1, 2, 3}},
+		{name: "round-robin skips inactive", strategy: StrategyRoundRobin, inactive: []int{2}, release: true, picks: 4, want: []int{1, 3, 1, 3}},
+		{name: "least-loaded spreads held tasks", strategy: StrategyLeastLoaded, picks: 4, want: []int{1, 2, 3, 1}},
+		{name: "least-loaded avoids busy workers", strategy: StrategyLeastLoaded, loaded: []int{1, 2}, picks: 2, want: []int{3, 1}},
+		{name: "smooth weighted", strategy: StrategyWeighted, weights: []int{5, 1, 1}, release: true, picks: 7, want: []int{1, 1, 2, 1, 3, 1, 1}},
+		{name: "weighted skips inactive", strategy: StrategyWeighted, weights: []int{2, 1, 1}, inactive: []int{1}, release: true, picks: 4, want: []int{2, 3, 2, 3}},
+		{name: "p2c uses only active workers", strategy: StrategyPowerOfTwo, inactive: []int{2, 3}, picks: 3, want: []int{1, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lb := NewLoadBalancerWithStrategy(3, tt.strategy)
+			for i, weight := range tt.weights {
+				lb.SetWeight(i+1, weight)
+			}
+			for _, id := range tt.inactive {
+				lb.Deactivate(id)
+			}
+			for _, id := range tt.loaded {
+				worker, _ := lb.worker(id)
+				worker.Load.Add(1)
+			}
+
+			var got []int
+			for range tt.picks {
+				worker := lb.GetWorker()
+				if worker == nil {
+					t.Fatal("no worker")
+				}
+				got = append(got, worker.ID)
+				if tt.release {
+					lb.Complete(worker, true)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("picked %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNoActiveWorkers(t *testing.T) {
+	for _, strategy := range []Strategy{StrategyRoundRobin, StrategyLeastLoaded, StrategyWeighted, StrategyPowerOfTwo} {
+		lb := NewLoadBalancerWithStrategy(2, strategy)
+		lb.Deactivate(1)
+		lb.Deactivate(2)
+		if worker := lb.GetWorker(); worker != nil {
+			t.Errorf("%s: expected no worker, got %d", strategy, worker.ID)
+		}
+		if err := lb.Activate(3); err == nil {
+			t.Errorf("%s: unknown worker must be reported", strategy)
+		}
+	}
+}
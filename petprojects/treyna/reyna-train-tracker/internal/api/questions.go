@@ -0,0 +1,264 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// QuestionContext всё, что нужно вопросу для ответа
+type QuestionContext struct {
+	Tracker     *tracker.TrainTracker
+	CurrentTime time.Time
+	Position    *models.CurrentPosition
+	WorkerID    int
+	Lang        i18n.Lang // Язык ответа
+}
+
+// Question вопрос о путешествии
+// Паттерн: Plugin - новый вопрос добавляется регистрацией, без правки обработчика
+type Question interface {
+	// ID номер вопроса, он же порядок вывода
+	ID() int
+	// Text текст вопроса на языке lang (или на языке по умолчанию)
+	Text(lang i18n.Lang) string
+	// Answer вычисляет ответ
+	Answer(ctx QuestionContext) map[string]interface{}
+	// Validate проверяет, что ответ полный
+	Validate(answer map[string]interface{}) bool
+}
+
+// FuncQuestion вопрос из функции и списка обязательных полей ответа
+type FuncQuestion struct {
+	QuestionID int
+	Texts      map[i18n.Lang]string // Язык -> текст вопроса
+	Compute    func(ctx QuestionContext) map[string]interface{}
+	Required   []string // Поля, без которых ответ считается неполным
+}
+
+// ID номер вопроса
+func (q *FuncQuestion) ID() int {
+	return q.QuestionID
+}
+
+// Text текст вопроса на языке lang
+func (q *FuncQuestion) Text(lang i18n.Lang) string {
+	if text, ok := q.Texts[lang]; ok {
+		return text
+	}
+	return q.Texts[i18n.Default]
+}
+
+// Answer вычисляет ответ
+func (q *FuncQuestion) Answer(ctx QuestionContext) map[string]interface{} {
+	return q.Compute(ctx)
+}
+
+// Validate проверяет отсутствие ошибки и наличие обязательных полей
+func (q *FuncQuestion) Validate(answer map[string]interface{}) bool {
+	if _, hasError := answer["error"]; hasError {
+		return false
+	}
+	for _, key := range q.Required {
+		if answer[key] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// QuestionRegistry набор вопросов, на которые отвечает обработчик
+type QuestionRegistry struct {
+	mu        sync.RWMutex
+	questions map[int]Question
+}
+
+// NewQuestionRegistry создаёт пустой реестр
+func NewQuestionRegistry() *QuestionRegistry {
+	return &QuestionRegistry{questions: make(map[int]Question)}
+}
+
+// Register добавляет вопрос; номера вопросов не должны повторяться
+func (r *QuestionRegistry) Register(q Question) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if q.ID() <= 0 {
+		return fmt.Errorf("invalid question id: %d", q.ID())
+	}
+	if _, exists := r.questions[q.ID()]; exists {
+		return fmt.Errorf("question %d already registered", q.ID())
+	}
+	r.questions[q.ID()] = q
+	return nil
+}
+
+// MustRegister как Register, но паникует при ошибке (для встроенных вопросов)
+func (r *QuestionRegistry) MustRegister(questions ...Question) {
+	for _, q := range questions {
+		if err := r.Register(q); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Unregister убирает вопрос из реестра
+func (r *QuestionRegistry) Unregister(id int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.questions, id)
+}
+
+// Get возвращает вопрос по номеру
+func (r *QuestionRegistry) Get(id int) (Question, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	q, ok := r.questions[id]
+	return q, ok
+}
+
+// All возвращает все вопросы по возрастанию номера
+func (r *QuestionRegistry) All() []Question {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	all := make([]Question, 0, len(r.questions))
+	for _, q := range r.questions {
+		all = append(all, q)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ID() < all[j].ID()
+	})
+	return all
+}
+
+// Len количество зарегистрированных вопросов
+func (r *QuestionRegistry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.questions)
+}
+
+// RegisterBuiltinQuestions регистрирует 10 исходных вопросов
+func RegisterBuiltinQuestions(r *QuestionRegistry, h *QuestionHandler) {
+	r.MustRegister(
+		&FuncQuestion{
+			QuestionID: 1,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Какое сейчас локальное время у пассажира?",
+				i18n.English: "What is the passenger's local time now?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question1_LocalTime(ctx.CurrentTime, ctx.Position)
+			},
+			Required: []string{"local_time", "timezone"},
+		},
+		&FuncQuestion{
+			QuestionID: 2,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "На какой станции пассажир сейчас находится?",
+				i18n.English: "Which station is the passenger at?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question2_CurrentStation(ctx.Position, ctx.Lang)
+			},
+			Required: []string{"distance_from_moscow"},
+		},
+		&FuncQuestion{
+			QuestionID: 3,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Поезд стоит или в пути?",
+				i18n.English: "Is the train standing or moving?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question3_TrainStatus(ctx.CurrentTime, ctx.Position, ctx.Lang)
+			},
+			Required: []string{"status"},
+		},
+		&FuncQuestion{
+			QuestionID: 4,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Какой день путешествия?",
+				i18n.English: "Which day of the journey is it?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question4_JourneyDay(ctx.CurrentTime, ctx.Lang)
+			},
+			Required: []string{"day_number"},
+		},
+		&FuncQuestion{
+			QuestionID: 5,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Какое расстояние от Москвы?",
+				i18n.English: "How far from Moscow is the train?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question5_Distance(ctx.Position, ctx.Lang)
+			},
+			Required: []string{"distance_km"},
+		},
+		&FuncQuestion{
+			QuestionID: 6,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Когда пассажир прибудет на следующую станцию?",
+				i18n.English: "When does the passenger reach the next station?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question6_NextArrival(ctx.CurrentTime, ctx.Position, ctx.Lang)
+			},
+			Required: []string{"next_station", "arrival_time"},
+		},
+		&FuncQuestion{
+			QuestionID: 7,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Какая разница во времени между Москвой и текущим городом?",
+				i18n.English: "What is the time difference between Moscow and the current city?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question7_TimeDifference(ctx.CurrentTime, ctx.Position, ctx.Lang)
+			},
+			Required: []string{"difference"},
+		},
+		&FuncQuestion{
+			QuestionID: 8,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Если я пишу сейчас, когда она получит?",
+				i18n.English: "If I write now, when will she receive it?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question8_MessageToHer(ctx.CurrentTime, ctx.Position, ctx.Lang)
+			},
+			Required: []string{"send_time_moscow"},
+		},
+		&FuncQuestion{
+			QuestionID: 9,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Если она пишет сейчас, когда я получу?",
+				i18n.English: "If she writes now, when will I receive it?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question9_MessageFromHer(ctx.CurrentTime, ctx.Position, ctx.Lang)
+			},
+			Required: []string{"send_time_local"},
+		},
+		&FuncQuestion{
+			QuestionID: 10,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Какие основные станции впереди и когда прибытие?",
+				i18n.English: "Which major stations are ahead and when do we arrive?",
+			},
+			Compute: func(ctx QuestionContext) map[string]interface{} {
+				return h.Question10_UpcomingStations(ctx.Position, ctx.Lang)
+			},
+			Required: []string{"upcoming_stations"},
+		},
+	)
+}
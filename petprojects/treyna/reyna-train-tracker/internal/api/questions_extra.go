@@ -0,0 +1,104 @@
+package api
+
+import (
+	"fmt"
+
+	"reyna-train-tracker/internal/i18n"
+)
+
+// Станции, между которыми путь идёт по берегу Байкала
+const (
+	baikalShoreStart = "Слюдянка 1"
+	baikalShoreEnd   = "Мысовая"
+)
+
+// RegisterExtraQuestions регистрирует дополнительные вопросы (пример плагинов)
+func RegisterExtraQuestions(r *QuestionRegistry) {
+	r.MustRegister(
+		&FuncQuestion{
+			QuestionID: 11,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "Сколько км до следующего часового пояса?",
+				i18n.English: "How many km until the next timezone?",
+			},
+			Compute:  nextTimezoneAnswer,
+			Required: []string{"distance_km"},
+		},
+		&FuncQuestion{
+			QuestionID: 12,
+			Texts: map[i18n.Lang]string{
+				i18n.Russian: "С какой стороны поезда будет Байкал?",
+				i18n.English: "Which side of the train faces Lake Baikal?",
+			},
+			Compute:  baikalSideAnswer,
+			Required: []string{"side"},
+		},
+	)
+}
+
+// nextTimezoneAnswer ищет ближайшую границу впереди, на которой переводят часы
+func nextTimezoneAnswer(ctx QuestionContext) map[string]interface{} {
+	pos := ctx.Position
+	if pos == nil {
+		return map[string]interface{}{"error": "Position not found"}
+	}
+
+	changes := ctx.Tracker.UpcomingTimezoneChanges(ctx.CurrentTime)
+	if len(changes) == 0 {
+		return map[string]interface{}{
+			"distance_km": 0,
+			"timezone":    pos.Timezone,
+			"note":        i18n.T(ctx.Lang, "answer.same_timezone"),
+		}
+	}
+
+	next := changes[0]
+	return map[string]interface{}{
+		"distance_km":   int(next.DistanceKm),
+		"between":       fmt.Sprintf("%s - %s", i18n.StationName(ctx.Lang, next.Border.PrevStation), i18n.StationName(ctx.Lang, next.Border.NextStation)),
+		"next_timezone": next.Border.To,
+		"clock_shift":   i18n.FormatOffset(ctx.Lang, next.Shift),
+		"crossing_in":   i18n.FormatDuration(ctx.Lang, next.In),
+		"estimated":     next.Border.Estimated,
+	}
+}
+
+// baikalSideAnswer определяет, у какого окна сидеть, чтобы видеть Байкал
+func baikalSideAnswer(ctx QuestionContext) map[string]interface{} {
+	pos := ctx.Position
+	if pos == nil {
+		return map[string]interface{}{"error": "Position not found"}
+	}
+
+	start, okStart := ctx.Tracker.GetStationByName(baikalShoreStart)
+	end, okEnd := ctx.Tracker.GetStationByName(baikalShoreEnd)
+	if !okStart || !okEnd {
+		return map[string]interface{}{"error": "Baikal is not on this route"}
+	}
+
+	// Путь идёт по южному берегу: на восток озеро слева, на запад - справа
+	side := i18n.T(ctx.Lang, "answer.side_left")
+	if start.DistanceFromStart > end.DistanceFromStart {
+		side = i18n.T(ctx.Lang, "answer.side_right")
+		start, end = end, start
+	}
+
+	answer := map[string]interface{}{
+		"side":  side,
+		"shore": fmt.Sprintf("%s - %s", i18n.StationName(ctx.Lang, start.Name), i18n.StationName(ctx.Lang, end.Name)),
+	}
+
+	distance := int(pos.DistanceFromStart)
+	switch {
+	case distance < start.DistanceFromStart:
+		answer["status"] = i18n.T(ctx.Lang, "answer.baikal_ahead")
+		answer["distance_km"] = start.DistanceFromStart - distance
+		answer["arrival_time"] = start.ArrivalTime.Format("15:04 02.01.2006")
+	case distance <= end.DistanceFromStart:
+		answer["status"] = i18n.T(ctx.Lang, "answer.baikal_now")
+	default:
+		answer["status"] = i18n.T(ctx.Lang, "answer.baikal_behind")
+	}
+
+	return answer
+}
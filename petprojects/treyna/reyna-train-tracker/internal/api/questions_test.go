@@ -0,0 +1,137 @@
+package api_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+func TestRegistry(t *testing.T) {
+	r := api.NewQuestionRegistry()
+	tests := []struct {
+		name    string
+		id      int
+		wantErr bool
+	}{
+		{"first", 3, false},
+		{"second", 1, false},
+		{"zero id", 0, true},
+		{"negative id", -1, true},
+		{"duplicate", 3, true},
+	}
+	for _, tt := range tests {
+		err := r.Register(&api.FuncQuestion{QuestionID: tt.id})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Register(%d) = %v", tt.name, tt.id, err)
+		}
+	}
+
+	var ids []int
+	for _, q := range r.All() {
+		ids = append(ids, q.ID())
+	}
+	if !slices.Equal(ids, []int{1, 3}) || r.Len() != 2 {
+		t.Errorf("All must list questions by number: %v", ids)
+	}
+	r.Unregister(3)
+	if _, ok := r.Get(3); ok {
+		t.Error("unregistered question is still there")
+	}
+}
+
+func TestFuncQuestionValidate(t *testing.T) {
+	q := &api.FuncQuestion{Required: []string{"station"}}
+	tests := []struct {
+		answer map[string]interface{}
+		want   bool
+	}{
+		{map[string]interface{}{"station": "Omsk"}, true},
+		{map[string]interface{}{"station": nil}, false},
+		{map[string]interface{}{}, false},
+		{map[string]interface{}{"station": "Omsk", "error": "boom"}, false},
+	}
+	for _, tt := range tests {
+		if got := q.Validate(tt.answer); got != tt.want {
+			t.Errorf("Validate(%v) = %v", tt.answer, got)
+		}
+	}
+}
+
+// TestAnswersOrderedByNumber ответы - по номеру вопроса, а не в порядке готовности;
+// плагин отвечает наравне со встроенными вопросами
+func TestAnswersOrderedByNumber(t *testing.T) {
+	_, handler := trackertest.New(t, departureDay)
+	handler.Questions.MustRegister(&api.FuncQuestion{
+		QuestionID: 42,
+		Texts:      map[i18n.Lang]string{i18n.Russian: "Тест?"},
+		Compute: func(ctx api.QuestionContext) map[string]interface{} {
+			return map[string]interface{}{"worker": ctx.WorkerID}
+		},
+		Required: []string{"worker"},
+	})
+
+	tests := []struct {
+		name    string
+		numbers []int
+		want    []int
+	}{
+		{"all questions", nil, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 42}},
+		{"selected", []int{42, 5, 1, 99}, []int{1, 5, 42, 99}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := handler.ProcessQuestionsIn(departureDay, i18n.English, tt.numbers)
+			var got []int
+			for _, result := range results {
+				got = append(got, result.QuestionNumber)
+				answer, _ := result.Answer.(map[string]interface{})
+				_, failed := answer["error"]
+				if failed != (result.QuestionNumber == 99) {
+					t.Errorf("question %d: unexpected answer %v", result.QuestionNumber, result.Answer)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("answers in order %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNextArrivalInMoscowTime прогноз прибытия выводится по Москве,
+// в каком бы поясе ни пришёл момент запроса
+func TestNextArrivalInMoscowTime(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	at := time.Date(2025, 10, 7, 0, 10, 0, 0, msk)
+	trainTracker, handler := trackertest.New(t, at)
+	pos := trainTracker.GetCurrentPosition(at)
+
+	vladivostok, err := time.LoadLocation("Asia/Vladivostok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		at   time.Time
+	}{
+		{"moscow", at},
+		{"utc", at.UTC()},
+		{"vladivostok", at.In(vladivostok)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer := handler.Question6_NextArrival(tt.at, pos, i18n.Russian)
+			if answer["arrival_time"] != "01:10 07.10.2025" || answer["scheduled_time"] != "01:10 07.10.2025" {
+				t.Errorf("arrival %v, scheduled %v, want 01:10 07.10.2025 for both", answer["arrival_time"], answer["scheduled_time"])
+			}
+			if window := answer["arrival_window"].(string); !strings.HasPrefix(window, "01:0") || !strings.Contains(window, " - 01:1") {
+				t.Errorf("arrival window %q is not in Moscow time", window)
+			}
+		})
+	}
+}
@@ -1,10 +1,17 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"time"
+
+	"reyna-train-tracker/internal/clock"
 )
 
+// ErrRateLimiterClosed возвращается при ожидании токена у остановленного лимитера
+var ErrRateLimiterClosed = errors.New("rate limiter closed")
+
 // RateLimiter ограничивает частоту запросов
 // Паттерн: Token Bucket Rate Limiter
 type RateLimiter struct {
@@ -12,18 +19,31 @@ type RateLimiter struct {
 	maxTokens      int
 	refillRate     time.Duration
 	lastRefillTime time.Time
+	clock          clock.Clock
 	mu             sync.Mutex
+
+	stop      chan struct{} // Сигнал остановки горутины пополнения
+	done      chan struct{} // Закрывается после выхода горутины пополнения
+	closeOnce sync.Once
 }
 
 // NewRateLimiter создаёт новый rate limiter
 // maxTokens - максимальное количество токенов
 // refillRate - как часто добавляется новый токен
 func NewRateLimiter(maxTokens int, refillRate time.Duration) *RateLimiter {
+	return NewRateLimiterWithClock(maxTokens, refillRate, clock.Real())
+}
+
+// NewRateLimiterWithClock создаёт rate limiter, который пополняет токены и ждёт по часам clk
+func NewRateLimiterWithClock(maxTokens int, refillRate time.Duration, clk clock.Clock) *RateLimiter {
 	rl := &RateLimiter{
 		tokens:         maxTokens,
 		maxTokens:      maxTokens,
 		refillRate:     refillRate,
-		lastRefillTime: time.Now(),
+		lastRefillTime: clk.Now(),
+		clock:          clk,
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
 	}
 
 	// Запускаем горутину для пополнения токенов
@@ -48,21 +68,51 @@ func (rl *RateLimiter) Allow() bool {
 // Wait ждёт, пока не появится доступный токен
 func (rl *RateLimiter) Wait() {
 	for !rl.Allow() {
-		time.Sleep(rl.refillRate / 10)
+		rl.clock.Sleep(rl.refillRate / 10)
 	}
 }
 
+// WaitContext ждёт токен с учётом контекста и остановки лимитера
+func (rl *RateLimiter) WaitContext(ctx context.Context) error {
+	for !rl.Allow() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-rl.stop:
+			return ErrRateLimiterClosed
+		case <-rl.clock.After(rl.refillRate / 10):
+		}
+	}
+	return nil
+}
+
+// Close останавливает пополнение токенов. Повторные вызовы безопасны
+func (rl *RateLimiter) Close() {
+	rl.closeOnce.Do(func() {
+		close(rl.stop)
+	})
+	<-rl.done
+}
+
 // refillTokens периодически пополняет токены
 func (rl *RateLimiter) refillTokens() {
-	ticker := time.NewTicker(rl.refillRate)
+	defer close(rl.done)
+
+	ticker := rl.clock.NewTicker(rl.refillRate)
 	defer ticker.Stop()
 
-	for range ticker.C {
+	for {
+		select {
+		case <-rl.stop:
+			return
+		case <-ticker.C():
+		}
+
 		rl.mu.Lock()
 		if rl.tokens < rl.maxTokens {
 			rl.tokens++
 		}
-		rl.lastRefillTime = time.Now()
+		rl.lastRefillTime = rl.clock.Now()
 		rl.mu.Unlock()
 	}
 }
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrQueueFull очередь воркера заполнена (и время ожидания истекло)
+	ErrQueueFull = errors.New("worker queue is full")
+	// ErrPoolClosed пул остановлен и задачи не принимает
+	ErrPoolClosed = errors.New("worker pool closed")
+)
+
+// Job задача для пула. Получает ID воркера и возвращает успешность выполнения
+type Job func(workerID int) bool
+
+// WorkerPool пул долгоживущих воркеров с ограниченными очередями
+// Паттерн: Worker Pool + Backpressure.
+// У каждого воркера load balancer'а своя горутина и своя очередь;
+// Submit выбирает воркера стратегией балансировщика и кладёт задачу в его очередь.
+// Если очередь полна, задача отклоняется сразу (queueTimeout == 0)
+// или после ожидания не дольше queueTimeout
+type WorkerPool struct {
+	lb           *LoadBalancer
+	queues       map[int]chan Job // ID воркера -> очередь
+	queueTimeout time.Duration
+
+	mu       sync.RWMutex // Submit держит RLock, Close - Lock, чтобы не писать в закрытые очереди
+	closed   bool
+	quit     chan struct{} // Будит Submit, ожидающие место в очереди
+	quitOnce sync.Once
+	wg       sync.WaitGroup
+
+	depth     atomic.Int64  // Задач в очередях прямо сейчас
+	maxDepth  atomic.Int64  // Максимальная глубина очередей за всё время
+	submitted atomic.Uint64 // Принято задач
+	rejected  atomic.Uint64 // Отклонено из-за переполнения
+	completed atomic.Uint64 // Выполнено задач
+}
+
+// NewWorkerPool запускает по горутине на каждого воркера балансировщика.
+// queueSize - общий размер очередей, делится поровну между воркерами
+func NewWorkerPool(lb *LoadBalancer, queueSize int, queueTimeout time.Duration) *WorkerPool {
+	perWorker := max(queueSize/max(len(lb.workers), 1), 1)
+
+	p := &WorkerPool{
+		lb:           lb,
+		queues:       make(map[int]chan Job, len(lb.workers)),
+		queueTimeout: queueTimeout,
+		quit:         make(chan struct{}),
+	}
+
+	for _, worker := range lb.workers {
+		queue := make(chan Job, perWorker)
+		p.queues[worker.ID] = queue
+
+		p.wg.Add(1)
+		go p.run(worker, queue)
+	}
+
+	return p
+}
+
+// run цикл воркера: выполняет задачи из своей очереди, пока она не закрыта
+func (p *WorkerPool) run(worker *Worker, queue <-chan Job) {
+	defer p.wg.Done()
+
+	for job := range queue {
+		p.depth.Add(-1)
+		success := job(worker.ID)
+		p.lb.Complete(worker, success)
+		p.completed.Add(1)
+	}
+}
+
+// Submit ставит задачу в очередь воркера, выбранного балансировщиком
+func (p *WorkerPool) Submit(ctx context.Context, job Job) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return ErrPoolClosed
+	}
+
+	worker := p.lb.GetWorker()
+	if worker == nil {
+		return ErrNoActiveWorkers
+	}
+	queue := p.queues[worker.ID]
+
+	// Сначала пробуем без ожидания
+	select {
+	case queue <- job:
+		p.enqueued()
+		return nil
+	default:
+	}
+
+	if p.queueTimeout <= 0 {
+		return p.reject(worker, ErrQueueFull)
+	}
+
+	timer := time.NewTimer(p.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case queue <- job:
+		p.enqueued()
+		return nil
+	case <-timer.C:
+		return p.reject(worker, ErrQueueFull)
+	case <-ctx.Done():
+		return p.reject(worker, ctx.Err())
+	case <-p.quit:
+		return p.reject(worker, ErrPoolClosed)
+	}
+}
+
+func (p *WorkerPool) enqueued() {
+	p.submitted.Add(1)
+	depth := p.depth.Add(1)
+	for {
+		maxDepth := p.maxDepth.Load()
+		if depth <= maxDepth || p.maxDepth.CompareAndSwap(maxDepth, depth) {
+			return
+		}
+	}
+}
+
+func (p *WorkerPool) reject(worker *Worker, err error) error {
+	p.rejected.Add(1)
+	p.lb.ReleaseWorker(worker)
+	return err
+}
+
+// Close перестаёт принимать задачи, дожидается выполнения уже
+// поставленных в очередь и останавливает горутины воркеров
+func (p *WorkerPool) Close() {
+	// Сначала будим Submit, ждущие места в очереди, иначе Lock ждал бы их таймаута
+	p.quitOnce.Do(func() {
+		close(p.quit)
+	})
+
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		for _, queue := range p.queues {
+			close(queue)
+		}
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
+
+// GetQueueStats возвращает метрики очередей
+func (p *WorkerPool) GetQueueStats() map[string]interface{} {
+	depths := make(map[int]int, len(p.queues))
+	capacity := 0
+	for id, queue := range p.queues {
+		depths[id] = len(queue)
+		capacity += cap(queue)
+	}
+
+	return map[string]interface{}{
+		"queue_depth":     p.depth.Load(),
+		"queue_max_depth": p.maxDepth.Load(),
+		"queue_capacity":  capacity,
+		"worker_depths":   depths,
+		"submitted":       p.submitted.Load(),
+		"rejected":        p.rejected.Load(),
+		"completed":       p.completed.Load(),
+	}
+}
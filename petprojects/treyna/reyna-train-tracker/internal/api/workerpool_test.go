@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// blockingJob задача, которая ждёт gate и отмечает воркера, на котором выполнилась
+func blockingJob(gate <-chan struct{}, ran *atomic.Int32, workers chan<- int) Job {
+	return func(workerID int) bool {
+		<-gate
+		ran.Add(1)
+		if workers != nil {
+			workers <- workerID
+		}
+		return true
+	}
+}
+
+// TestPoolCloseDrainsQueuedJobs Close дожидается задач, уже стоящих в очередях
+func TestPoolCloseDrainsQueuedJobs(t *testing.T) {
+	pool := NewWorkerPool(NewLoadBalancer(2), 10, 0)
+	gate := make(chan struct{})
+	var ran atomic.Int32
+	for i := range 8 {
+		if err := pool.Submit(context.Background(), blockingJob(gate, &ran, nil)); err != nil {
+			t.Fatalf("job %d: %v", i, err)
+		}
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		pool.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+		t.Fatal("Close returned before queued jobs finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(gate)
+	<-closed
+	if ran.Load() != 8 || pool.GetQueueStats()["completed"] != uint64(8) {
+		t.Errorf("queued jobs lost on close: ran %d, stats %v", ran.Load(), pool.GetQueueStats())
+	}
+	if err := pool.Submit(context.Background(), blockingJob(gate, &ran, nil)); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("submit after close: expected ErrPoolClosed, got %v", err)
+	}
+}
+
+// TestSubmitToFullQueue очередь одного воркера на одну задачу: воркер занят, очередь полна
+func TestSubmitToFullQueue(t *testing.T) {
+	tests := []struct {
+		name         string
+		queueTimeout time.Duration
+		cancel       bool // Отменить контекст Submit
+		closePool    bool // Закрыть пул, пока Submit ждёт места
+		want         error
+	}{
+		{name: "reject at once", want: ErrQueueFull},
+		{name: "reject after timeout", queueTimeout: 20 * time.Millisecond, want: ErrQueueFull},
+		{name: "context cancelled", queueTimeout: time.Minute, cancel: true, want: context.Canceled},
+		{name: "pool closed while waiting", queueTimeout: time.Minute, closePool: true, want: ErrPoolClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lb := NewLoadBalancer(1)
+			pool := NewWorkerPool(lb, 1, tt.queueTimeout)
+			gate := make(chan struct{})
+			var ran atomic.Int32
+			started := make(chan int, 1)
+			defer func() {
+				close(gate)
+				pool.Close()
+			}()
+
+			pool.Submit(context.Background(), blockingJob(gate, &ran, started)) // Занимает воркера
+			for pool.GetQueueStats()["queue_depth"] != int64(0) {
+				time.Sleep(time.Millisecond)
+			}
+			if err := pool.Submit(context.Background(), blockingJob(gate, &ran, nil)); err != nil {
+				t.Fatalf("queue slot: %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			switch {
+			case tt.cancel:
+				time.AfterFunc(10*time.Millisecond, cancel)
+			case tt.closePool:
+				time.AfterFunc(10*time.Millisecond, func() {
+					go pool.Close()
+				})
+			}
+
+			if err := pool.Submit(ctx, blockingJob(gate, &ran, nil)); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+			if pool.GetQueueStats()["rejected"] != uint64(1) {
+				t.Errorf("rejection not counted: %v", pool.GetQueueStats())
+			}
+			if worker, _ := lb.worker(1); worker.Load.Load() != 2 {
+				t.Errorf("rejected job must release the worker, load %d", worker.Load.Load())
+			}
+		})
+	}
+}
+
+// TestDrainWorker draining-воркер доделывает свои задачи, новые уходят другим,
+// а выключается он только когда освободится
+func TestDrainWorker(t *testing.T) {
+	lb := NewLoadBalancer(2)
+	pool := NewWorkerPool(lb, 10, 0)
+	defer pool.Close()
+
+	gate := make(chan struct{})
+	var ran atomic.Int32
+	workers := make(chan int, 10)
+	pool.Submit(context.Background(), blockingJob(gate, &ran, workers)) // Воркер 1
+
+	// Отмена ожидания не выключает воркер, но и задач он больше не получает
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := lb.Drain(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline while worker 1 is busy, got %v", err)
+	}
+
+	drained := make(chan error, 1)
+	go func() { drained <- lb.Drain(context.Background(), 1) }()
+
+	for range 3 {
+		pool.Submit(context.Background(), blockingJob(gate, &ran, workers))
+	}
+	select {
+	case err := <-drained:
+		t.Fatalf("Drain returned with a task in progress: %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(gate)
+	if err := <-drained; err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	got := map[int]int{}
+	for range 4 {
+		got[<-workers]++
+	}
+	if got[1] != 1 || got[2] != 3 {
+		t.Errorf("draining worker received new tasks: %v", got)
+	}
+
+	stats := lb.GetWorkerStats()[0]
+	if stats["active"] != false || stats["draining"] != false || stats["successes"] != uint64(1) {
+		t.Errorf("drained worker must end inactive: %v", stats)
+	}
+	if err := lb.Activate(1); err != nil || !lb.workers[0].IsActive() {
+		t.Errorf("Activate after drain: %v", err)
+	}
+}
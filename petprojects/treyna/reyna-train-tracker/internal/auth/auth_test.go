@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/config"
+)
+
+func TestScopesAndStoredHashes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	store, err := NewStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAuthenticator(store, Limits{}, clock.NewFake(time.Now()))
+	defer a.Close()
+
+	_, family, err := a.CreateKey("family", []Scope{ScopeTimetable}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, admin, err := a.CreateKey("admin", []Scope{ScopeAdmin}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := a.CreateKey("nobody", nil, 0, 0); err == nil {
+		t.Error("key without scopes accepted")
+	}
+
+	if _, err := a.Authorize(family, ScopeTimetable); err != nil {
+		t.Errorf("timetable key rejected for timetable: %v", err)
+	}
+	if _, err := a.Authorize(family, ScopePosition); !errors.Is(err, ErrForbidden) {
+		t.Errorf("timetable key for position: expected ErrForbidden, got %v", err)
+	}
+	if _, err := a.Authorize(admin, ScopePosition); err != nil {
+		t.Errorf("admin key must allow every scope: %v", err)
+	}
+	if _, err := a.Authorize("rtk_unknown", ScopeTimetable); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("unknown key: expected ErrUnauthorized, got %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), family) || strings.Contains(string(data), admin) {
+		t.Error("key file must contain only hashes")
+	}
+	reopened, err := NewStore(path)
+	if err != nil || len(reopened.Keys()) != 2 {
+		t.Fatalf("keys were not persisted: %v", err)
+	}
+}
+
+func TestRateLimitAndDailyQuota(t *testing.T) {
+	clk := clock.NewFake(time.Date(2025, 10, 10, 23, 0, 0, 0, time.UTC))
+	store, _ := NewStore("")
+	a := NewAuthenticator(store, Limits{RatePerMinute: 60, Burst: 2, DailyQuota: 100}, clk)
+	defer a.Close()
+
+	key, limited, _ := a.CreateKey("limited", []Scope{ScopePosition}, 0, 0)
+	_, quoted, _ := a.CreateKey("quoted", []Scope{ScopePosition}, 0, 1)
+
+	for i := range 2 {
+		if _, err := a.Authorize(limited, ScopePosition); err != nil {
+			t.Fatalf("request %d within burst rejected: %v", i+1, err)
+		}
+	}
+	var limit *LimitError
+	if _, err := a.Authorize(limited, ScopePosition); !errors.As(err, &limit) || !errors.Is(err, ErrRateLimited) || limit.RetryAfter != time.Second {
+		t.Errorf("expected rate limit with 1s retry, got %v", err)
+	}
+	if usage := a.Usage(key); usage.Requests != 2 || usage.Rejected != 1 {
+		t.Errorf("unexpected usage: %+v", usage)
+	}
+
+	if _, err := a.Authorize(quoted, ScopePosition); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Authorize(quoted, ScopePosition); !errors.As(err, &limit) || !errors.Is(err, ErrQuotaExceeded) || limit.RetryAfter != time.Hour {
+		t.Errorf("expected quota exceeded until midnight, got %v", err)
+	}
+	// Квота считается по суткам UTC
+	clk.Set(time.Date(2025, 10, 11, 0, 0, 1, 0, time.UTC))
+	if _, err := a.Authorize(quoted, ScopePosition); err != nil {
+		t.Errorf("quota must reset at midnight UTC: %v", err)
+	}
+}
+
+func TestRevokeKeyStopsMetering(t *testing.T) {
+	store, _ := NewStore("")
+	a := NewAuthenticator(store, Limits{RatePerMinute: 60, Burst: 2}, clock.NewFake(time.Now()))
+	defer a.Close()
+
+	key, token, _ := a.CreateKey("family", []Scope{ScopePosition}, 0, 0)
+	if _, err := a.Authorize(token, ScopePosition); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.RevokeKey(key.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Запрос, прошедший Lookup до отзыва, доходит до учёта уже после него
+	if m := a.meterFor(key); m != nil {
+		t.Error("revoked key got a new meter")
+	}
+	if len(a.meters) != 0 {
+		t.Errorf("revoked key left %d meters with running limiters", len(a.meters))
+	}
+	if _, err := a.Authorize(token, ScopePosition); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("revoked key: expected ErrUnauthorized, got %v", err)
+	}
+	if usage := a.Usage(key); usage != (Usage{}) {
+		t.Errorf("revoked key usage: %+v", usage)
+	}
+	if err := a.RevokeKey(key.ID); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("second revoke: expected ErrKeyNotFound, got %v", err)
+	}
+}
+
+func TestRateLimits(t *testing.T) {
+	tests := []struct {
+		rate     int
+		valid    bool
+		interval time.Duration
+	}{
+		{rate: 1, valid: true, interval: time.Minute},
+		{rate: 7, valid: true, interval: 8571428572 * time.Nanosecond},
+		{rate: MaxRatePerMinute, valid: true, interval: time.Millisecond},
+		{rate: MaxRatePerMinute + 1, valid: false},
+		{rate: -1, valid: false},
+	}
+	for _, tt := range tests {
+		key := Key{Scopes: []Scope{ScopePosition}, RatePerMinute: tt.rate}
+		if err := key.Validate(); (err == nil) != tt.valid {
+			t.Errorf("rate %d: valid=%v, got %v", tt.rate, tt.valid, err)
+		}
+		if tt.valid {
+			if got := rateInterval(tt.rate); got != tt.interval {
+				t.Errorf("rate %d: interval %v, want %v", tt.rate, got, tt.interval)
+			}
+		}
+	}
+
+	// Ключ из файла с частотой сверх предела не должен обнулить паузу лимитера
+	store, _ := NewStore("")
+	a := NewAuthenticator(store, Limits{Burst: 1}, clock.NewFake(time.Date(2025, 10, 10, 12, 0, 0, 0, time.UTC)))
+	defer a.Close()
+	token := newToken()
+	key := Key{ID: newID(), Hash: Hash(token), Scopes: []Scope{ScopePosition}, RatePerMinute: 1 << 40}
+	if err := store.Add(key); err != nil {
+		t.Fatal(err)
+	}
+	a.Authorize(token, ScopePosition)
+	var limit *LimitError
+	if _, err := a.Authorize(token, ScopePosition); !errors.As(err, &limit) || limit.RetryAfter != time.Millisecond {
+		t.Errorf("expected the rate to be capped, got %v", err)
+	}
+}
+
+func TestAuthenticatorWithConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.Config
+		wantNil bool
+		wantErr error
+	}{
+		{name: "disabled without opt-out", cfg: config.Config{}, wantErr: ErrAuthDisabled},
+		{name: "explicitly insecure", cfg: config.Config{AuthAllowInsecure: true}, wantNil: true},
+		{name: "enabled", cfg: config.Config{AuthEnabled: true, AuthRatePerMinute: 120}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.AuthKeysPath = filepath.Join(t.TempDir(), "keys.json")
+			a, err := NewAuthenticatorWithConfig(&tt.cfg)
+			if !errors.Is(err, tt.wantErr) || (a == nil) != (tt.wantNil || tt.wantErr != nil) {
+				t.Fatalf("got %v, %v", a, err)
+			}
+			if a != nil {
+				a.Close()
+			}
+		})
+	}
+
+	cfg := config.Config{AuthEnabled: true, AuthRatePerMinute: MaxRatePerMinute + 1}
+	if _, err := NewAuthenticatorWithConfig(&cfg); err == nil {
+		t.Error("default rate above the cap must be rejected")
+	}
+}
@@ -0,0 +1,276 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/config"
+)
+
+// Ошибки проверки ключа
+var (
+	ErrUnauthorized  = errors.New("missing or unknown api key")
+	ErrForbidden     = errors.New("api key lacks the required scope")
+	ErrRateLimited   = errors.New("api key rate limit exceeded")
+	ErrQuotaExceeded = errors.New("api key daily quota exceeded")
+	ErrAuthDisabled  = errors.New("auth is disabled: set AUTH_ENABLED=true or explicitly allow a public API with AUTH_ALLOW_INSECURE=true")
+)
+
+// LimitError отказ по лимиту ключа: через RetryAfter запрос стоит повторить
+type LimitError struct {
+	Err        error // ErrRateLimited или ErrQuotaExceeded
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string { return e.Err.Error() }
+func (e *LimitError) Unwrap() error { return e.Err }
+
+// Limits лимиты ключей по умолчанию (ключ может задать свои)
+type Limits struct {
+	RatePerMinute int // 0 - без ограничения частоты
+	Burst         int // Сколько запросов подряд можно сделать без паузы
+	DailyQuota    int // Запросов в сутки (по UTC); 0 - без квоты
+}
+
+// Usage учёт запросов ключа с момента запуска сервера
+type Usage struct {
+	Requests      int64     `json:"requests"`
+	Today         int64     `json:"today"`
+	Rejected      int64     `json:"rejected"`
+	RatePerMinute int       `json:"rate_per_minute"`
+	DailyQuota    int       `json:"daily_quota"`
+	LastUsedAt    time.Time `json:"last_used_at,omitzero"`
+}
+
+// meter лимитер и счётчики одного ключа
+type meter struct {
+	limiter  *api.RateLimiter // nil - частота не ограничена
+	interval time.Duration    // Пауза между токенами лимитера
+	quota    int
+
+	mu    sync.Mutex
+	day   string
+	usage Usage
+}
+
+// Authenticator проверяет ключи и права и ведёт учёт запросов по каждому ключу:
+// частоту ограничивает свой RateLimiter ключа, сверху - суточная квота
+type Authenticator struct {
+	Store  *Store
+	limits Limits
+	clock  clock.Clock
+
+	mu      sync.Mutex
+	meters  map[string]*meter   // По ID ключа
+	revoked map[string]struct{} // ID отозванных ключей: учёт для них больше не заводится
+}
+
+// NewAuthenticator создаёт проверку ключей. clk nil - системные часы
+func NewAuthenticator(store *Store, limits Limits, clk clock.Clock) *Authenticator {
+	if clk == nil {
+		clk = clock.Real()
+	}
+	return &Authenticator{
+		Store:   store,
+		limits:  limits,
+		clock:   clk,
+		meters:  make(map[string]*meter),
+		revoked: make(map[string]struct{}),
+	}
+}
+
+// NewAuthenticatorWithConfig создаёт проверку ключей по конфигурации.
+// Выключить аутентификацию можно только явно (AUTH_ALLOW_INSECURE):
+// тогда возвращается nil и API открыт всем, иначе - ErrAuthDisabled.
+// Лимиты идут по системным часам, а не по часам трекера: ускоренная
+// симуляция не должна ускорять квоты
+func NewAuthenticatorWithConfig(cfg *config.Config) (*Authenticator, error) {
+	if !cfg.AuthEnabled {
+		if cfg.AuthAllowInsecure {
+			return nil, nil
+		}
+		return nil, ErrAuthDisabled
+	}
+	if cfg.AuthRatePerMinute < 0 || cfg.AuthRatePerMinute > MaxRatePerMinute {
+		return nil, fmt.Errorf("AUTH_RATE_PER_MINUTE must be between 0 and %d", MaxRatePerMinute)
+	}
+	store, err := NewStore(cfg.AuthKeysPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewAuthenticator(store, Limits{
+		RatePerMinute: cfg.AuthRatePerMinute,
+		Burst:         cfg.AuthBurst,
+		DailyQuota:    cfg.AuthDailyQuota,
+	}, nil), nil
+}
+
+// CreateKey создаёт ключ и возвращает его вместе с самим значением -
+// оно показывается один раз, в хранилище остаётся только хеш
+func (a *Authenticator) CreateKey(name string, scopes []Scope, ratePerMinute, dailyQuota int) (Key, string, error) {
+	return CreateKey(a.Store, name, scopes, ratePerMinute, dailyQuota, a.clock.Now())
+}
+
+// CreateKey создаёт ключ прямо в хранилище (для cmd/apikeys)
+func CreateKey(store *Store, name string, scopes []Scope, ratePerMinute, dailyQuota int, now time.Time) (Key, string, error) {
+	token := newToken()
+	key := Key{
+		ID:            newID(),
+		Name:          name,
+		Prefix:        token[:len(tokenPrefix)+6],
+		Hash:          Hash(token),
+		Scopes:        scopes,
+		RatePerMinute: ratePerMinute,
+		DailyQuota:    dailyQuota,
+		CreatedAt:     now,
+	}
+	if err := key.Validate(); err != nil {
+		return Key{}, "", err
+	}
+	if err := store.Add(key); err != nil {
+		return Key{}, "", err
+	}
+	return key, token, nil
+}
+
+// RevokeKey удаляет ключ и его учёт
+func (a *Authenticator) RevokeKey(id string) error {
+	if err := a.Store.Revoke(id); err != nil {
+		return err
+	}
+	a.mu.Lock()
+	m := a.meters[id]
+	delete(a.meters, id)
+	a.revoked[id] = struct{}{}
+	a.mu.Unlock()
+	if m != nil && m.limiter != nil {
+		m.limiter.Close()
+	}
+	return nil
+}
+
+// Authorize проверяет ключ token на право scope и учитывает запрос.
+// Ошибки: ErrUnauthorized, ErrForbidden или *LimitError
+func (a *Authenticator) Authorize(token string, scope Scope) (Key, error) {
+	now := a.clock.Now()
+	if token == "" {
+		return Key{}, ErrUnauthorized
+	}
+	key, ok := a.Store.Lookup(token, now)
+	if !ok {
+		return Key{}, ErrUnauthorized
+	}
+	if !key.Allows(scope) {
+		return key, fmt.Errorf("%w %q", ErrForbidden, scope)
+	}
+	// Ключ могли отозвать между Lookup и учётом
+	m := a.meterFor(key)
+	if m == nil {
+		return Key{}, ErrUnauthorized
+	}
+	return key, m.take(now)
+}
+
+// Usage учёт запросов ключа
+func (a *Authenticator) Usage(key Key) Usage {
+	m := a.meterFor(key)
+	if m == nil {
+		return Usage{}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.usage
+}
+
+// Close останавливает лимитеры ключей
+func (a *Authenticator) Close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for id, m := range a.meters {
+		if m.limiter != nil {
+			m.limiter.Close()
+		}
+		delete(a.meters, id)
+	}
+}
+
+// meterFor учёт ключа; создаётся при первом запросе.
+// nil - ключ отозван: иначе запрос, успевший пройти Lookup до RevokeKey,
+// завёл бы новый лимитер, который уже некому остановить
+func (a *Authenticator) meterFor(key Key) *meter {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.revoked[key.ID]; ok {
+		return nil
+	}
+
+	if m, ok := a.meters[key.ID]; ok {
+		return m
+	}
+	// Ключи из файла могли миновать Validate: частоту всё равно держим в пределах
+	rate := min(orDefault(key.RatePerMinute, a.limits.RatePerMinute), MaxRatePerMinute)
+	m := &meter{quota: orDefault(key.DailyQuota, a.limits.DailyQuota)}
+	m.usage.RatePerMinute, m.usage.DailyQuota = rate, m.quota
+	if rate > 0 {
+		m.interval = rateInterval(rate)
+		m.limiter = api.NewRateLimiterWithClock(max(a.limits.Burst, 1), m.interval, a.clock)
+	}
+	a.meters[key.ID] = m
+	return m
+}
+
+// take учитывает запрос: сначала суточная квота, потом токен лимитера
+func (m *meter) take(now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if day := now.UTC().Format(time.DateOnly); day != m.day {
+		m.day, m.usage.Today = day, 0
+	}
+	if m.quota > 0 && m.usage.Today >= int64(m.quota) {
+		m.usage.Rejected++
+		midnight := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+		return &LimitError{Err: ErrQuotaExceeded, RetryAfter: midnight.Sub(now)}
+	}
+	if m.limiter != nil && !m.limiter.Allow() {
+		m.usage.Rejected++
+		return &LimitError{Err: ErrRateLimited, RetryAfter: m.interval}
+	}
+	m.usage.Requests++
+	m.usage.Today++
+	m.usage.LastUsedAt = now
+	return nil
+}
+
+// rateInterval пауза между токенами для rate запросов в минуту, с округлением вверх:
+// частота не превышает заданную, а пауза не бывает нулевой
+func rateInterval(rate int) time.Duration {
+	return max((time.Minute+time.Duration(rate)-1)/time.Duration(rate), time.Millisecond)
+}
+
+func orDefault(value, fallback int) int {
+	if value > 0 {
+		return value
+	}
+	return fallback
+}
+
+// RequestToken ключ из запроса: "Authorization: Bearer <ключ>" или "X-API-Key: <ключ>"
+func RequestToken(r *http.Request) string {
+	return TokenFromHeaders(r.Header.Get("Authorization"), r.Header.Get("X-API-Key"))
+}
+
+// TokenFromHeaders ключ из значений заголовков authorization и x-api-key (HTTP и метаданные gRPC)
+func TokenFromHeaders(authorization, apiKey string) string {
+	if token, ok := strings.CutPrefix(authorization, "Bearer "); ok {
+		return strings.TrimSpace(token)
+	}
+	return strings.TrimSpace(apiKey)
+}
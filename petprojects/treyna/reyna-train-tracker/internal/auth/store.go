@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrKeyNotFound ключа с таким ID нет
+var ErrKeyNotFound = errors.New("api key not found")
+
+// reloadInterval как часто сервер проверяет, не изменил ли файл ключей cmd/apikeys
+const reloadInterval = 2 * time.Second
+
+// storeFile содержимое файла ключей
+type storeFile struct {
+	Keys []Key `json:"keys"`
+}
+
+// Store ключи в памяти с файлом JSON (запись во временный файл и rename).
+// Файл могут менять и сервер, и cmd/apikeys: каждое изменение перечитывает файл
+// перед записью, а поиск ключа подхватывает чужие изменения не реже reloadInterval
+type Store struct {
+	path string
+
+	mu        sync.RWMutex
+	keys      map[string]Key    // По ID
+	byHash    map[string]string // Хеш -> ID
+	modTime   time.Time         // Время изменения прочитанного файла
+	checkedAt time.Time         // Последняя проверка файла
+}
+
+// NewStore загружает ключи; пустой path - только в памяти.
+// Отсутствие файла - не ошибка: он появится при первом ключе
+func NewStore(path string) (*Store, error) {
+	s := &Store{path: path}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.loadLocked(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Add сохраняет новый ключ
+func (s *Store) Add(key Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.loadLocked(); err != nil {
+		return err
+	}
+	s.keys[key.ID] = key
+	s.byHash[key.Hash] = key.ID
+	return s.saveLocked()
+}
+
+// Revoke удаляет ключ: следующий запрос с ним получит 401
+func (s *Store) Revoke(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.loadLocked(); err != nil {
+		return err
+	}
+	key, ok := s.keys[id]
+	if !ok {
+		return ErrKeyNotFound
+	}
+	delete(s.keys, id)
+	delete(s.byHash, key.Hash)
+	return s.saveLocked()
+}
+
+// Keys все ключи по времени создания
+func (s *Store) Keys() []Key {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]Key, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys
+}
+
+// Lookup ключ по предъявленному значению
+func (s *Store) Lookup(token string, now time.Time) (Key, bool) {
+	s.refresh(now)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[s.byHash[Hash(token)]]
+	return key, ok
+}
+
+// refresh перечитывает файл, если его изменили со стороны.
+// Ошибка чтения оставляет прежние ключи: битый файл не должен открывать или закрывать доступ
+func (s *Store) refresh(now time.Time) {
+	if s.path == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.checkedAt) < reloadInterval {
+		return
+	}
+	s.checkedAt = now
+	if info, err := os.Stat(s.path); err == nil && !info.ModTime().Equal(s.modTime) {
+		s.loadLocked()
+	}
+}
+
+// loadLocked читает файл ключей; без файла хранилище пустое
+func (s *Store) loadLocked() error {
+	keys := make(map[string]Key)
+	byHash := make(map[string]string)
+	if s.path == "" {
+		if s.keys == nil {
+			s.keys, s.byHash = keys, byHash
+		}
+		return nil
+	}
+
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		s.keys, s.byHash, s.modTime = keys, byHash, time.Time{}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read api keys: %w", err)
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read api keys: %w", err)
+	}
+
+	var file storeFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to decode api keys %s: %w", s.path, err)
+	}
+	for _, key := range file.Keys {
+		keys[key.ID] = key
+		byHash[key.Hash] = key.ID
+	}
+	s.keys, s.byHash, s.modTime = keys, byHash, info.ModTime()
+	return nil
+}
+
+// saveLocked записывает ключи в файл, если он задан
+func (s *Store) saveLocked() error {
+	if s.path == "" {
+		return nil
+	}
+
+	file := storeFile{Keys: make([]Key, 0, len(s.keys))}
+	for _, key := range s.keys {
+		file.Keys = append(file.Keys, key)
+	}
+	sort.Slice(file.Keys, func(i, j int) bool {
+		return file.Keys[i].CreatedAt.Before(file.Keys[j].CreatedAt)
+	})
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode api keys: %w", err)
+	}
+
+	// CreateTemp создаёт файл с правами 0600: хеши ключей посторонним ни к чему
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create api keys file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write api keys: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write api keys: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace api keys: %w", err)
+	}
+	if info, err := os.Stat(s.path); err == nil {
+		s.modTime = info.ModTime()
+	}
+	return nil
+}
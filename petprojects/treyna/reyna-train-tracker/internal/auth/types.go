@@ -0,0 +1,111 @@
+// Package auth API-ключи: хранение хешей, права (scopes) и учёт запросов по ключу
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Scope право ключа
+type Scope string
+
+// Права ключей. Admin включает все остальные
+const (
+	ScopePosition  Scope = "position"  // Позиция, статус, ответы на вопросы, журнал
+	ScopeTimetable Scope = "timetable" // Расписание
+	ScopeAdmin     Scope = "admin"     // Отметки об опозданиях, вебхуки, воркеры, ключи
+)
+
+// Scopes все права
+func Scopes() []Scope {
+	return []Scope{ScopePosition, ScopeTimetable, ScopeAdmin}
+}
+
+// ParseScopes права из списка "position,timetable"
+func ParseScopes(raw string) ([]Scope, error) {
+	var scopes []Scope
+	for _, part := range strings.Split(raw, ",") {
+		scope := Scope(strings.TrimSpace(part))
+		if scope == "" {
+			continue
+		}
+		if !slices.Contains(Scopes(), scope) {
+			return nil, fmt.Errorf("unknown scope: %q", scope)
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+// tokenPrefix начало каждого ключа: по нему ключ легко узнать в логах и конфигах
+const tokenPrefix = "rtk_"
+
+// MaxRatePerMinute предел частоты ключа: не чаще запроса в миллисекунду.
+// Пауза лимитера - минута, делённая на частоту, и должна оставаться ненулевой
+const MaxRatePerMinute = 60000
+
+// Key API-ключ. Сам ключ не хранится - только его SHA-256
+type Key struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Prefix        string    `json:"prefix"`         // Первые символы ключа, чтобы отличать ключи в списке
+	Hash          string    `json:"hash,omitempty"` // SHA-256 ключа (hex)
+	Scopes        []Scope   `json:"scopes"`
+	RatePerMinute int       `json:"rate_per_minute,omitempty"` // 0 - по умолчанию из конфигурации
+	DailyQuota    int       `json:"daily_quota,omitempty"`     // 0 - по умолчанию из конфигурации
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Allows есть ли у ключа право scope
+func (k Key) Allows(scope Scope) bool {
+	return slices.Contains(k.Scopes, ScopeAdmin) || slices.Contains(k.Scopes, scope)
+}
+
+// Public ключ без хеша - для ответов API
+func (k Key) Public() Key {
+	k.Hash = ""
+	return k
+}
+
+// Validate проверяет права и лимиты ключа
+func (k Key) Validate() error {
+	if len(k.Scopes) == 0 {
+		return fmt.Errorf("key needs at least one scope: %v", Scopes())
+	}
+	for _, scope := range k.Scopes {
+		if !slices.Contains(Scopes(), scope) {
+			return fmt.Errorf("unknown scope: %q", scope)
+		}
+	}
+	if k.RatePerMinute < 0 || k.DailyQuota < 0 {
+		return fmt.Errorf("rate and quota must not be negative")
+	}
+	if k.RatePerMinute > MaxRatePerMinute {
+		return fmt.Errorf("rate must not exceed %d requests per minute", MaxRatePerMinute)
+	}
+	return nil
+}
+
+// Hash SHA-256 ключа. Ключи случайные и длинные, поэтому медленный хеш не нужен
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// newToken случайный ключ "rtk_<64 hex>"
+func newToken() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return tokenPrefix + hex.EncodeToString(b)
+}
+
+func newID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,166 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// Паузы между попытками, если Bot API недоступен
+const (
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+)
+
+// Bot отвечает на команды чата ответами обработчика вопросов
+// и рассылает подписанным чатам события поездки по расписанию
+type Bot struct {
+	Client      *Client
+	Handler     *api.QuestionHandler
+	Tracker     *tracker.TrainTracker
+	Language    i18n.Lang     // Язык, если у отправителя не указан поддерживаемый
+	PollTimeout time.Duration // Таймаут длинного опроса getUpdates
+	PushEvents  map[models.MilestoneKind]bool
+	MajorOnly   bool // Прибытия и отправления - только на крупных станциях
+	Logger      *log.Logger
+
+	mu     sync.Mutex
+	chats  map[int64]i18n.Lang // Чаты, подписанные на события, и их язык
+	offset int64               // Следующий update_id для getUpdates
+}
+
+// NewBotWithConfig создаёт бота; чаты из BOT_CHAT_IDS подписаны сразу
+func NewBotWithConfig(client *Client, h *api.QuestionHandler, cfg *config.Config) *Bot {
+	b := &Bot{
+		Client:      client,
+		Handler:     h,
+		Tracker:     h.Tracker,
+		Language:    i18n.ParseOrDefault(cfg.Language),
+		PollTimeout: cfg.BotPollTimeout,
+		PushEvents:  make(map[models.MilestoneKind]bool),
+		MajorOnly:   cfg.BotPushMajorOnly,
+		Logger:      log.Default(),
+		chats:       make(map[int64]i18n.Lang),
+	}
+	for _, kind := range cfg.BotPushEvents {
+		b.PushEvents[models.MilestoneKind(strings.TrimSpace(kind))] = true
+	}
+	for _, id := range cfg.BotChatIDs {
+		b.chats[id] = b.Language
+	}
+	return b
+}
+
+// Subscribe подписывает чат на события поездки
+func (b *Bot) Subscribe(chatID int64, lang i18n.Lang) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.chats[chatID] = lang
+}
+
+// Unsubscribe отписывает чат
+func (b *Bot) Unsubscribe(chatID int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.chats, chatID)
+}
+
+// Subscribers подписанные чаты и их языки (копия)
+func (b *Bot) Subscribers() map[int64]i18n.Lang {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	chats := make(map[int64]i18n.Lang, len(b.chats))
+	for id, lang := range b.chats {
+		chats[id] = lang
+	}
+	return chats
+}
+
+// Run опрашивает Bot API и рассылает события, пока не отменён ctx
+func (b *Bot) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		b.RunPushes(ctx)
+	}()
+	defer wg.Wait()
+
+	backoff := minBackoff
+	for {
+		updates, err := b.Client.GetUpdates(ctx, b.offset, b.PollTimeout)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			b.Logger.Printf("⚠️  getUpdates: %v, повтор через %s", err, backoff)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, maxBackoff)
+			continue
+		}
+		backoff = minBackoff
+
+		for _, u := range updates {
+			b.offset = u.UpdateID + 1
+			if u.Message != nil {
+				b.HandleMessage(ctx, u.Message)
+			}
+		}
+	}
+}
+
+// HandleMessage отвечает на команду; обычные сообщения семейного чата бот пропускает
+func (b *Bot) HandleMessage(ctx context.Context, m *Message) {
+	lang := b.Language
+	if m.From != nil {
+		if parsed, ok := i18n.Parse(m.From.LanguageCode); ok {
+			lang = parsed
+		}
+	}
+
+	command, ok := ParseCommand(m.Text)
+	if !ok {
+		return
+	}
+
+	switch command {
+	case "start":
+		b.Subscribe(m.Chat.ID, lang)
+	case "stop":
+		b.Unsubscribe(m.Chat.ID)
+	}
+
+	reply := b.Reply(command, b.Tracker.Now(), lang)
+	if _, err := b.Client.SendMessage(ctx, m.Chat.ID, reply); err != nil && !errors.Is(err, context.Canceled) {
+		b.Logger.Printf("⚠️  sendMessage в чат %d: %v", m.Chat.ID, err)
+	}
+}
+
+// ParseCommand выделяет команду из текста: "/where@reyna_bot сейчас" -> "where"
+func ParseCommand(text string) (string, bool) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "/") {
+		return "", false
+	}
+
+	command, _, _ := strings.Cut(text[1:], " ")
+	command, _, _ = strings.Cut(command, "@")
+	if command == "" {
+		return "", false
+	}
+	return strings.ToLower(command), true
+}
@@ -0,0 +1,129 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+const testToken = "test-token"
+
+// newTestBot бот на фальшивых часах и FakeAPI за httptest-сервером
+func newTestBot(t *testing.T, at time.Time) (*Bot, *FakeAPI) {
+	t.Helper()
+
+	_, handler := trackertest.New(t, at)
+
+	fakeAPI := NewFakeAPI(testToken)
+	srv := httptest.NewServer(fakeAPI)
+	t.Cleanup(srv.Close)
+
+	return NewBotWithConfig(NewClient(srv.URL, testToken), handler, trackertest.Config()), fakeAPI
+}
+
+func TestCommandsAgainstFakeAPI(t *testing.T) {
+	b, fakeAPI := newTestBot(t, time.Date(2025, 10, 12, 20, 30, 0, 0, time.FixedZone("MSK", 3*3600)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- b.Run(ctx) }()
+
+	fakeAPI.Send(42, User{ID: 7, LanguageCode: "en-US"}, "/start@reyna_bot")
+	fakeAPI.Send(42, User{ID: 7, LanguageCode: "en"}, "just chatting")
+	fakeAPI.Send(42, User{ID: 7, LanguageCode: "en"}, "/where")
+
+	sent, err := fakeAPI.WaitSent(ctx, 2)
+	if err != nil {
+		t.Fatalf("bot sent %d messages: %v", len(sent), err)
+	}
+	if sent[0].Chat.ID != 42 || !strings.Contains(sent[0].Text, "/where") {
+		t.Errorf("unexpected /start reply: %+v", sent[0])
+	}
+	if !strings.Contains(sent[1].Text, "km from Moscow") {
+		t.Errorf("unexpected /where reply: %q", sent[1].Text)
+	}
+	if lang, ok := b.Subscribers()[42]; !ok || lang != i18n.English {
+		t.Errorf("chat 42 is not subscribed in English: %v", b.Subscribers())
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(fakeAPI.Sent()) != 2 {
+		t.Errorf("plain chat messages must be ignored, sent: %+v", fakeAPI.Sent())
+	}
+}
+
+func TestBroadcastUsesChatLanguage(t *testing.T) {
+	b, fakeAPI := newTestBot(t, time.Date(2025, 10, 6, 12, 0, 0, 0, time.UTC))
+	b.Subscribe(1, i18n.Russian)
+	b.Subscribe(2, i18n.English)
+
+	b.Broadcast(context.Background(), models.Milestone{
+		At:       time.Date(2025, 10, 13, 18, 38, 0, 0, time.UTC),
+		Kind:     models.MilestoneTimezone,
+		Station:  "Архара",
+		Timezone: "Asia/Vladivostok",
+		Shift:    time.Hour,
+	})
+
+	texts := make(map[int64]string)
+	for _, m := range fakeAPI.Sent() {
+		texts[m.Chat.ID] = m.Text
+	}
+	if !strings.Contains(texts[1], "часы переводятся") || !strings.Contains(texts[2], "clocks move") {
+		t.Errorf("unexpected pushes: %v", texts)
+	}
+}
+
+func TestClientReportsAPIError(t *testing.T) {
+	srv := httptest.NewServer(NewFakeAPI(testToken))
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL, "wrong-token").SendMessage(context.Background(), 1, "hi")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 401 {
+		t.Fatalf("expected 401 APIError, got %v", err)
+	}
+}
+
+func TestReplyOffTrip(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	b, _ := newTestBot(t, time.Date(2025, 10, 6, 21, 0, 0, 0, msk))
+
+	tests := []struct {
+		name    string
+		command string
+		at      time.Time
+		want    string
+	}{
+		{"/where до отправления", "where", time.Date(2025, 10, 6, 21, 0, 0, 0, msk), "ещё не отправился со станции Москва: отправление через 1 час 30 минут"},
+		{"/time до отправления", "time", time.Date(2025, 10, 6, 21, 0, 0, 0, msk), "ещё не отправился"},
+		{"/next до отправления", "next", time.Date(2025, 10, 6, 21, 0, 0, 0, msk), "ещё не отправился"},
+		{"/where в момент отправления", "where", time.Date(2025, 10, 6, 22, 30, 0, 0, msk), "Москва"},
+		{"/where после прибытия", "where", time.Date(2025, 10, 14, 4, 0, 0, 0, msk), "прибыл на станцию Хабаровск 1"},
+		{"/next после прибытия", "next", time.Date(2025, 10, 14, 4, 0, 0, 0, msk), "прибыл на станцию Хабаровск 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply := b.Reply(tt.command, tt.at, i18n.Russian)
+			if !strings.Contains(reply, tt.want) {
+				t.Errorf("reply %q has no %q", reply, tt.want)
+			}
+		})
+	}
+
+	if reply := b.Reply("where", time.Date(2025, 10, 6, 22, 30, 0, 0, msk), i18n.Russian); strings.Contains(reply, "не отправился") {
+		t.Errorf("at departure the train is at the station, got %q", reply)
+	}
+}
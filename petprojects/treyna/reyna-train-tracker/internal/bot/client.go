@@ -0,0 +1,159 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultAPIURL адрес Telegram Bot API
+const DefaultAPIURL = "https://api.telegram.org"
+
+// User отправитель сообщения
+type User struct {
+	ID           int64  `json:"id"`
+	FirstName    string `json:"first_name"`
+	Username     string `json:"username,omitempty"`
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Chat чат, в котором пришло сообщение
+type Chat struct {
+	ID    int64  `json:"id"`
+	Type  string `json:"type,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// Message сообщение чата (подмножество полей Bot API, которые нужны боту)
+type Message struct {
+	MessageID int64  `json:"message_id"`
+	From      *User  `json:"from,omitempty"`
+	Chat      Chat   `json:"chat"`
+	Date      int64  `json:"date"`
+	Text      string `json:"text,omitempty"`
+}
+
+// Update входящее обновление из getUpdates
+type Update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *Message `json:"message,omitempty"`
+}
+
+// APIError ошибка, которую вернул Bot API (ok: false)
+type APIError struct {
+	Code        int    `json:"error_code,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("bot api error %d: %s", e.Code, e.Description)
+}
+
+// response конверт ответа Bot API
+type response struct {
+	OK     bool            `json:"ok"`
+	Result json.RawMessage `json:"result"`
+	APIError
+}
+
+// Client клиент Bot API. Адрес настраивается, поэтому бот работает
+// и с настоящим Telegram, и с локальным FakeAPI
+type Client struct {
+	BaseURL string
+	Token   string
+	HTTP    *http.Client
+}
+
+// NewClient создаёт клиента; пустой baseURL означает DefaultAPIURL
+func NewClient(baseURL, token string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultAPIURL
+	}
+	return &Client{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Token:   token,
+		HTTP:    &http.Client{},
+	}
+}
+
+// GetUpdates длинный опрос: ждёт обновления с номером >= offset не дольше timeout
+func (c *Client) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error) {
+	params := map[string]interface{}{
+		"offset":          offset,
+		"timeout":         int(timeout / time.Second),
+		"allowed_updates": []string{"message"},
+	}
+
+	var updates []Update
+	if err := c.call(ctx, "getUpdates", params, &updates); err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+// SendMessage отправляет текст в чат
+func (c *Client) SendMessage(ctx context.Context, chatID int64, text string) (*Message, error) {
+	params := map[string]interface{}{
+		"chat_id": chatID,
+		"text":    text,
+	}
+
+	var sent Message
+	if err := c.call(ctx, "sendMessage", params, &sent); err != nil {
+		return nil, err
+	}
+	return &sent, nil
+}
+
+// call вызывает метод Bot API: POST {BaseURL}/bot{Token}/{method} с JSON телом
+func (c *Client) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("encode %s params: %w", method, err)
+	}
+
+	url := fmt.Sprintf("%s/bot%s/%s", c.BaseURL, c.Token, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build %s request: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		// Токен входит в URL: не показываем его в ошибках и логах
+		return fmt.Errorf("%s: %w", method, redact(err, c.Token))
+	}
+	defer resp.Body.Close()
+
+	var envelope response
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("decode %s response (status %d): %w", method, resp.StatusCode, err)
+	}
+	if !envelope.OK {
+		apiErr := envelope.APIError
+		if apiErr.Code == 0 {
+			apiErr.Code = resp.StatusCode
+		}
+		return &apiErr
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(envelope.Result, result); err != nil {
+		return fmt.Errorf("decode %s result: %w", method, err)
+	}
+	return nil
+}
+
+// redact убирает токен из текста ошибки
+func redact(err error, token string) error {
+	if token == "" || !strings.Contains(err.Error(), token) {
+		return err
+	}
+	return fmt.Errorf("%s", strings.ReplaceAll(err.Error(), token, "<token>"))
+}
@@ -0,0 +1,152 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/utils"
+)
+
+// Параметры ответа на /call
+const (
+	callHorizon    = 24 * time.Hour // На сколько вперёд ищем окна
+	maxCallWindows = 4
+)
+
+// Reply текст ответа на команду в момент at
+func (b *Bot) Reply(command string, at time.Time, lang i18n.Lang) string {
+	switch command {
+	case "start":
+		return i18n.T(lang, "bot.subscribed") + "\n\n" + i18n.T(lang, "bot.help")
+	case "stop":
+		return i18n.T(lang, "bot.unsubscribed")
+	case "help":
+		return i18n.T(lang, "bot.help")
+	case "where":
+		return b.replyWhere(at, lang)
+	case "time":
+		return b.replyTime(at, lang)
+	case "next":
+		return b.replyNext(at, lang)
+	case "call":
+		return b.replyCall(at, lang)
+	}
+	return i18n.T(lang, "bot.unknown", "/"+command)
+}
+
+// replyWhere ответ вопроса 2: станция или перегон и километр
+func (b *Bot) replyWhere(at time.Time, lang i18n.Lang) string {
+	pos := b.position(at)
+	if pos == nil {
+		return b.offTrip(at, lang)
+	}
+
+	answer := b.Handler.Question2_CurrentStation(pos, lang)
+	if answer["at_station"] == true {
+		return i18n.T(lang, "bot.where_station", answer["station"], answer["distance_from_moscow"])
+	}
+	return i18n.T(lang, "bot.where_between", answer["previous"], answer["next"], answer["distance_from_moscow"])
+}
+
+// replyTime ответы вопросов 1 и 7: местное время, Москва и разница
+func (b *Bot) replyTime(at time.Time, lang i18n.Lang) string {
+	pos := b.position(at)
+	if pos == nil {
+		return b.offTrip(at, lang)
+	}
+
+	local := b.Handler.Question1_LocalTime(at, pos)
+	diff := b.Handler.Question7_TimeDifference(at, pos, lang)
+	lines := []string{
+		i18n.T(lang, "bot.time_local", local["local_time"], local["timezone"]),
+		i18n.T(lang, "bot.time_moscow", diff["moscow_time"], diff["difference"], diff["direction"]),
+	}
+	if change, ok := diff["clock_change"]; ok {
+		lines = append(lines, fmt.Sprintf("⚠️ %v", change))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// replyNext ответ вопроса 6: прогноз прибытия на следующую станцию
+func (b *Bot) replyNext(at time.Time, lang i18n.Lang) string {
+	pos := b.position(at)
+	if pos == nil {
+		return b.offTrip(at, lang)
+	}
+
+	answer := b.Handler.Question6_NextArrival(at, pos, lang)
+	if _, failed := answer["error"]; failed {
+		return b.offTrip(at, lang)
+	}
+	lines := []string{
+		i18n.T(lang, "bot.next", answer["next_station"], answer["arrival_time"], answer["time_remaining"]),
+		i18n.T(lang, "bot.next_scheduled", answer["scheduled_time"], answer["arrival_window"]),
+	}
+	if delay, ok := answer["delay"]; ok {
+		lines = append(lines, i18n.T(lang, "bot.next_delay", delay))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// replyCall окна для звонка на ближайшие сутки: и в Москве, и у пассажира не ночь
+func (b *Bot) replyCall(at time.Time, lang i18n.Lang) string {
+	windows := b.Tracker.CallWindows(at, at.Add(callHorizon), tracker.DefaultAwakeFrom, tracker.DefaultAwakeUntil)
+	if len(windows) == 0 {
+		return i18n.T(lang, "bot.call_none")
+	}
+
+	lines := []string{i18n.T(lang, "bot.call_header")}
+	for i, w := range windows {
+		if i == maxCallWindows {
+			break
+		}
+		lines = append(lines, i18n.T(lang, "bot.call_window",
+			formatTime(w.Start, "Europe/Moscow"), formatTime(w.End, "Europe/Moscow"),
+			formatTime(w.Start, w.Timezone), formatTime(w.End, w.Timezone),
+			i18n.FormatDuration(lang, w.Duration)))
+		if len(w.Stops) > 0 {
+			stops := make([]string, len(w.Stops))
+			for j, stop := range w.Stops {
+				stops[j] = i18n.StationName(lang, stop)
+			}
+			lines = append(lines, i18n.T(lang, "bot.call_stops", strings.Join(stops, ", ")))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// position позиция поезда в момент at; nil - поезд ещё не отправился или уже прибыл.
+// Трекер в эти моменты держит поезд на крайней станции, поэтому границы поездки
+// проверяем сами, как и панель (dashboard.Capture)
+func (b *Bot) position(at time.Time) *models.CurrentPosition {
+	stations := b.Tracker.Stations
+	if len(stations) == 0 || at.Before(stations[0].DepartureTime) || !at.Before(stations[len(stations)-1].ArrivalTime) {
+		return nil
+	}
+	return b.Tracker.GetCurrentPosition(at)
+}
+
+// offTrip ответ, когда поезд ещё не отправился или уже прибыл
+func (b *Bot) offTrip(at time.Time, lang i18n.Lang) string {
+	stations := b.Tracker.Stations
+	if len(stations) == 0 {
+		return i18n.T(lang, "bot.no_route")
+	}
+	if first := stations[0]; at.Before(first.DepartureTime) {
+		return i18n.T(lang, "bot.not_departed", i18n.StationName(lang, first.Name), i18n.FormatDuration(lang, first.DepartureTime.Sub(at)))
+	}
+	return i18n.T(lang, "bot.arrived", i18n.StationName(lang, stations[len(stations)-1].Name))
+}
+
+// formatTime время "15:04" в поясе timezone
+func formatTime(at time.Time, timezone string) string {
+	local, err := utils.ConvertToTimezone(at, timezone)
+	if err != nil {
+		return at.Format("15:04")
+	}
+	return local.Format("15:04")
+}
@@ -0,0 +1,181 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FakeAPI локальная замена Telegram Bot API в памяти: getUpdates и sendMessage.
+// Используется в тестах и в cmd/bot --fake, чтобы проверять бота без сети
+type FakeAPI struct {
+	Token string
+
+	mu      sync.Mutex
+	updates []Update  // Ещё не подтверждённые обновления
+	sent    []Message // Всё, что отправил бот
+	nextID  int64
+	changed chan struct{} // Закрывается при каждом изменении и создаётся заново
+}
+
+// NewFakeAPI создаёт фальшивый API, принимающий только указанный токен
+func NewFakeAPI(token string) *FakeAPI {
+	return &FakeAPI{
+		Token:   token,
+		nextID:  1,
+		changed: make(chan struct{}),
+	}
+}
+
+// Send имитирует сообщение пользователя в чат chatID
+func (f *FakeAPI) Send(chatID int64, from User, text string) Update {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	u := Update{
+		UpdateID: f.nextID,
+		Message: &Message{
+			MessageID: f.nextID,
+			From:      &from,
+			Chat:      Chat{ID: chatID, Type: "private"},
+			Date:      time.Now().Unix(),
+			Text:      text,
+		},
+	}
+	f.nextID++
+	f.updates = append(f.updates, u)
+	f.notifyLocked()
+	return u
+}
+
+// Sent сообщения, отправленные ботом
+func (f *FakeAPI) Sent() []Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Message(nil), f.sent...)
+}
+
+// WaitSent ждёт, пока бот отправит не меньше n сообщений
+func (f *FakeAPI) WaitSent(ctx context.Context, n int) ([]Message, error) {
+	for {
+		f.mu.Lock()
+		if len(f.sent) >= n {
+			sent := append([]Message(nil), f.sent...)
+			f.mu.Unlock()
+			return sent, nil
+		}
+		changed := f.changed
+		f.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return f.Sent(), ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// notifyLocked будит ожидающих getUpdates и WaitSent
+func (f *FakeAPI) notifyLocked() {
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// ServeHTTP обрабатывает POST /bot{token}/{method}
+func (f *FakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/bot")
+	token, method, ok := strings.Cut(path, "/")
+	if !ok || token != f.Token {
+		writeFake(w, http.StatusUnauthorized, response{APIError: APIError{Code: http.StatusUnauthorized, Description: "Unauthorized"}})
+		return
+	}
+
+	var params struct {
+		Offset  int64  `json:"offset"`
+		Timeout int    `json:"timeout"`
+		ChatID  int64  `json:"chat_id"`
+		Text    string `json:"text"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		writeFake(w, http.StatusBadRequest, response{APIError: APIError{Code: http.StatusBadRequest, Description: "Bad Request: invalid JSON"}})
+		return
+	}
+
+	switch method {
+	case "getUpdates":
+		f.getUpdates(r.Context(), w, params.Offset, time.Duration(params.Timeout)*time.Second)
+	case "sendMessage":
+		if params.Text == "" {
+			writeFake(w, http.StatusBadRequest, response{APIError: APIError{Code: http.StatusBadRequest, Description: "Bad Request: message text is empty"}})
+			return
+		}
+		writeResult(w, f.record(params.ChatID, params.Text))
+	default:
+		writeFake(w, http.StatusNotFound, response{APIError: APIError{Code: http.StatusNotFound, Description: "Not Found"}})
+	}
+}
+
+// getUpdates подтверждает обновления до offset и ждёт новые не дольше timeout
+func (f *FakeAPI) getUpdates(ctx context.Context, w http.ResponseWriter, offset int64, timeout time.Duration) {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		f.mu.Lock()
+		pending := f.updates[:0]
+		for _, u := range f.updates {
+			if u.UpdateID >= offset {
+				pending = append(pending, u)
+			}
+		}
+		f.updates = pending
+		if len(pending) > 0 || timeout <= 0 {
+			result := append([]Update{}, pending...)
+			f.mu.Unlock()
+			writeResult(w, result)
+			return
+		}
+		changed := f.changed
+		f.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline.C:
+			writeResult(w, []Update{})
+			return
+		case <-changed:
+		}
+	}
+}
+
+// record запоминает сообщение бота
+func (f *FakeAPI) record(chatID int64, text string) Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	m := Message{
+		MessageID: f.nextID,
+		Chat:      Chat{ID: chatID},
+		Date:      time.Now().Unix(),
+		Text:      text,
+	}
+	f.nextID++
+	f.sent = append(f.sent, m)
+	f.notifyLocked()
+	return m
+}
+
+func writeResult(w http.ResponseWriter, result interface{}) {
+	raw, _ := json.Marshal(result)
+	writeFake(w, http.StatusOK, response{OK: true, Result: raw})
+}
+
+func writeFake(w http.ResponseWriter, status int, resp response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
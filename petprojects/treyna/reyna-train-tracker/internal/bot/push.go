@@ -0,0 +1,75 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+)
+
+// RunPushes рассылает подписанным чатам события поездки по расписанию.
+// Ожидание идёт по часам трекера, поэтому с CLOCK_SPEED события приходят ускоренно
+func (b *Bot) RunPushes(ctx context.Context) {
+	stations := b.Tracker.Stations
+	if len(stations) == 0 {
+		return
+	}
+	clk := b.Tracker.Clock
+	arrival := stations[len(stations)-1].ArrivalTime
+
+	for _, m := range b.Tracker.Milestones(clk.Now(), arrival) {
+		if !b.wants(m) {
+			continue
+		}
+		if wait := m.At.Sub(clk.Now()); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-clk.After(wait):
+			}
+		}
+		b.Broadcast(ctx, m)
+	}
+}
+
+// wants нужно ли сообщать о событии
+func (b *Bot) wants(m models.Milestone) bool {
+	if !b.PushEvents[m.Kind] {
+		return false
+	}
+	if b.MajorOnly && (m.Kind == models.MilestoneArrival || m.Kind == models.MilestoneDeparture) {
+		station, ok := b.Tracker.GetStationByName(m.Station)
+		return ok && station.IsMajor
+	}
+	return true
+}
+
+// Broadcast отправляет событие всем подписанным чатам, каждому на его языке
+func (b *Bot) Broadcast(ctx context.Context, m models.Milestone) {
+	for chatID, lang := range b.Subscribers() {
+		if _, err := b.Client.SendMessage(ctx, chatID, PushText(m, lang)); err != nil && !errors.Is(err, context.Canceled) {
+			b.Logger.Printf("⚠️  Уведомление в чат %d: %v", chatID, err)
+		}
+	}
+}
+
+// PushText текст уведомления о событии поездки
+func PushText(m models.Milestone, lang i18n.Lang) string {
+	station := i18n.StationName(lang, m.Station)
+	switch m.Kind {
+	case models.MilestoneArrival:
+		return i18n.T(lang, "bot.push_arrival", station, formatTime(m.At, m.Timezone))
+	case models.MilestoneDeparture:
+		return i18n.T(lang, "bot.push_departure", station, formatTime(m.At, m.Timezone))
+	case models.MilestoneTimezone:
+		if m.Shift == 0 {
+			return i18n.T(lang, "bot.push_timezone_same", m.Timezone)
+		}
+		return i18n.T(lang, "bot.push_timezone", m.Timezone, i18n.FormatOffset(lang, m.Shift), formatTime(m.At, m.Timezone))
+	case models.MilestoneDate:
+		return i18n.T(lang, "bot.push_date", m.Date.Format("02.01.2006"), formatTime(m.At, "Europe/Moscow"))
+	}
+	return string(m.Kind) + " " + m.At.Format(time.RFC3339)
+}
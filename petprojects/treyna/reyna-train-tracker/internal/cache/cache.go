@@ -4,6 +4,7 @@ import (
 	"sync"
 	"time"
 
+	"reyna-train-tracker/internal/clock"
 	"reyna-train-tracker/internal/models"
 )
 
@@ -12,22 +13,98 @@ import (
 type Cache[T any] interface {
 	Set(key string, value T, ttl time.Duration)
 	Get(key string) (T, bool)
+	// GetOrLoad возвращает значение из кэша, а при промахе вызывает loader
+	// и кэширует результат. Одновременные промахи по ключу разделяют один вызов loader
+	GetOrLoad(key string, ttl time.Duration, loader func() (T, error)) (T, error)
 	Delete(key string)
 	Clear()
+	Close()
+}
+
+// StatsCache кэш, который отдаёт свой размер и счётчики вытеснений
+type StatsCache[T any] interface {
+	Cache[T]
+	Size() int
+	Stats() Stats
+}
+
+// EvictionReason причина, по которой запись покинула кэш без явного Delete
+type EvictionReason int
+
+const (
+	EvictionCapacity EvictionReason = iota // Вытеснена политикой при переполнении
+	EvictionExpired                        // Истёк TTL
+	EvictionRejected                       // Новая запись не прошла фильтр допуска
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionCapacity:
+		return "capacity"
+	case EvictionExpired:
+		return "expired"
+	case EvictionRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
+
+// Options настройки ограниченного кэша
+type Options[T any] struct {
+	// Capacity максимальный суммарный размер записей (0 - без ограничений).
+	// Без SizeEstimator каждая запись имеет размер 1, т.е. это лимит количества
+	Capacity int
+	// Policy политика вытеснения, по умолчанию LRU
+	Policy EvictionPolicy
+	// SizeEstimator оценивает размер записи
+	SizeEstimator func(key string, value T) int
+	// OnEvict вызывается после вытеснения записи (вне блокировки кэша)
+	OnEvict func(key string, value T, reason EvictionReason)
+	// Clock часы для TTL и тикера очистки, по умолчанию системные
+	Clock clock.Clock
+}
+
+// Stats счётчики кэша
+type Stats struct {
+	Entries     int    // Количество записей
+	Size        int    // Суммарный размер записей
+	Capacity    int    // Лимит размера (0 - без ограничений)
+	Evictions   uint64 // Вытеснено при переполнении
+	Expirations uint64 // Удалено по TTL
+	Rejections  uint64 // Отклонено фильтром допуска
 }
 
 // InMemoryCache реализация кэша в памяти с RWMutex для защиты
 // Паттерн: In-memory cache + RWMutex для concurrent access
 type InMemoryCache[T any] struct {
-	data map[string]models.CacheEntry[T]
-	mu   sync.RWMutex // RWMutex позволяет множественное чтение, но эксклюзивную запись
+	store    *shard[T] // Одна map под одним RWMutex
+	counters counters
+	flights  flightGroup[T] // Объединение одновременных загрузок в GetOrLoad
+	sizeOf   func(key string, value T) int
+	onEvict  func(key string, value T, reason EvictionReason)
+	clock    clock.Clock
+
+	stop      chan struct{} // Сигнал остановки горутины очистки
+	done      chan struct{} // Закрывается, когда горутина очистки завершилась
+	closeOnce sync.Once
 }
 
-// NewInMemoryCache создаёт новый кэш
+// NewInMemoryCache создаёт новый кэш без ограничения размера
 func NewInMemoryCache[T any]() *InMemoryCache[T] {
+	return NewInMemoryCacheWithOptions(Options[T]{})
+}
+
+// NewInMemoryCacheWithOptions создаёт кэш с ограничением размера и политикой вытеснения
+func NewInMemoryCacheWithOptions[T any](opts Options[T]) *InMemoryCache[T] {
 	cache := &InMemoryCache[T]{
-		data: make(map[string]models.CacheEntry[T]),
+		sizeOf:  opts.SizeEstimator,
+		onEvict: opts.OnEvict,
+		clock:   clockOrReal(opts.Clock),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
 	}
+	cache.store = newShard[T](opts.Capacity, opts.Policy, &cache.counters)
 
 	// Запускаем горутину для очистки устаревших записей
 	go cache.cleanupExpired()
@@ -35,76 +112,140 @@ func NewInMemoryCache[T any]() *InMemoryCache[T] {
 	return cache
 }
 
-// Set добавляет значение в кэш с TTL
+// Set добавляет значение в кэш с TTL.
+// При переполнении вытесняет записи согласно политике
 func (c *InMemoryCache[T]) Set(key string, value T, ttl time.Duration) {
-	c.mu.Lock()         // Эксклюзивная блокировка для записи
-	defer c.mu.Unlock()
-
-	c.data[key] = models.CacheEntry[T]{
+	entry := models.CacheEntry[T]{
 		Value:     value,
-		Timestamp: time.Now(),
+		Timestamp: c.clock.Now(),
 		TTL:       ttl,
+		Size:      entrySize(c.sizeOf, key, value),
 	}
+
+	notify(c.onEvict, c.store.set(key, entry))
 }
 
-// Get получает значение из кэша
+// Get получает значение из кэша (истёкшие записи считаются отсутствующими)
 func (c *InMemoryCache[T]) Get(key string) (T, bool) {
-	c.mu.RLock()         // Разделяемая блокировка для чтения
-	defer c.mu.RUnlock()
-
-	entry, ok := c.data[key]
-	if !ok {
-		var zero T
-		return zero, false
-	}
-
-	// Проверяем, не истёк ли TTL
-	if entry.TTL > 0 && time.Since(entry.Timestamp) > entry.TTL {
-		var zero T
-		return zero, false
-	}
+	return c.store.get(key, c.clock.Now())
+}
 
-	return entry.Value, true
+// GetOrLoad возвращает значение из кэша или загружает его (cache-aside + SingleFlight)
+func (c *InMemoryCache[T]) GetOrLoad(key string, ttl time.Duration, loader func() (T, error)) (T, error) {
+	return getOrLoad[T](c, &c.flights, key, ttl, loader)
 }
 
 // Delete удаляет значение из кэша
 func (c *InMemoryCache[T]) Delete(key string) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	delete(c.data, key)
+	c.store.delete(key)
 }
 
 // Clear очищает весь кэш
 func (c *InMemoryCache[T]) Clear() {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.store.clear()
+}
 
-	c.data = make(map[string]models.CacheEntry[T])
+// Close останавливает горутину очистки и дожидается её завершения.
+// Повторные вызовы безопасны
+func (c *InMemoryCache[T]) Close() {
+	c.closeOnce.Do(func() {
+		close(c.stop)
+	})
+	<-c.done
 }
 
-// cleanupExpired периодически очищает устаревшие записи
+// cleanupExpired периодически очищает устаревшие записи,
+// просматривая всю map под эксклюзивной блокировкой
 func (c *InMemoryCache[T]) cleanupExpired() {
-	ticker := time.NewTicker(1 * time.Minute)
+	defer close(c.done)
+
+	ticker := c.clock.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		c.mu.Lock()
-		now := time.Now()
-		for key, entry := range c.data {
-			if entry.TTL > 0 && now.Sub(entry.Timestamp) > entry.TTL {
-				delete(c.data, key)
-			}
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C():
 		}
-		c.mu.Unlock()
+
+		removed, _ := c.store.removeExpired(c.clock.Now(), 0)
+		notify(c.onEvict, removed)
 	}
 }
 
 // Size возвращает количество элементов в кэше
 func (c *InMemoryCache[T]) Size() int {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	entries, _ := c.store.usage()
+	return entries
+}
+
+// Stats возвращает размер кэша и счётчики вытеснений
+func (c *InMemoryCache[T]) Stats() Stats {
+	entries, size := c.store.usage()
+	return c.counters.snapshot(entries, size, c.store.capacity)
+}
+
+// Entries возвращает копию неистёкших записей вместе с их Timestamp и TTL
+func (c *InMemoryCache[T]) Entries() map[string]models.CacheEntry[T] {
+	entries := make(map[string]models.CacheEntry[T])
+	c.store.copyEntries(entries, c.clock.Now())
+	return entries
+}
 
-	return len(c.data)
+// Now текущее время по часам кэша
+func (c *InMemoryCache[T]) Now() time.Time {
+	return c.clock.Now()
 }
 
+// Clock часы кэша
+func (c *InMemoryCache[T]) Clock() clock.Clock {
+	return c.clock
+}
+
+// SetEntry кладёт запись как есть, сохраняя её Timestamp и TTL
+func (c *InMemoryCache[T]) SetEntry(key string, entry models.CacheEntry[T]) {
+	entry.Size = entrySize(c.sizeOf, key, entry.Value)
+	notify(c.onEvict, c.store.set(key, entry))
+}
+
+// snapshot собирает Stats из счётчиков и текущего заполнения
+func (c *counters) snapshot(entries, size, capacity int) Stats {
+	return Stats{
+		Entries:     entries,
+		Size:        size,
+		Capacity:    capacity,
+		Evictions:   c.evictions.Load(),
+		Expirations: c.expirations.Load(),
+		Rejections:  c.rejections.Load(),
+	}
+}
+
+// notify вызывает колбэк вытеснения вне блокировок
+func notify[T any](onEvict func(key string, value T, reason EvictionReason), removed []evicted[T]) {
+	if onEvict == nil {
+		return
+	}
+	for _, e := range removed {
+		onEvict(e.key, e.value, e.reason)
+	}
+}
+
+// clockOrReal часы из настроек или системные
+func clockOrReal(c clock.Clock) clock.Clock {
+	if c == nil {
+		return clock.Real()
+	}
+	return c
+}
+
+// entrySize оценивает размер записи (по умолчанию 1)
+func entrySize[T any](sizeOf func(key string, value T) int, key string, value T) int {
+	if sizeOf == nil {
+		return 1
+	}
+	if size := sizeOf(key, value); size > 0 {
+		return size
+	}
+	return 1
+}
@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+const benchKeys = 4096
+
+// benchCaches реализации, которые сравниваем на одинаковой нагрузке
+func benchCaches() map[string]func() StatsCache[int] {
+	return map[string]func() StatsCache[int]{
+		"InMemory": func() StatsCache[int] {
+			return NewInMemoryCache[int]()
+		},
+		"Sharded": func() StatsCache[int] {
+			return NewShardedCache(ShardedOptions[int]{})
+		},
+	}
+}
+
+func benchKeyNames() []string {
+	keys := make([]string, benchKeys)
+	for i := range keys {
+		keys[i] = "position_" + strconv.Itoa(i)
+	}
+	return keys
+}
+
+func BenchmarkCacheParallelGet(b *testing.B) {
+	keys := benchKeyNames()
+	for name, newCache := range benchCaches() {
+		b.Run(name, func(b *testing.B) {
+			c := newCache()
+			defer c.Close()
+			for i, key := range keys {
+				c.Set(key, i, time.Minute)
+			}
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					c.Get(keys[i%benchKeys])
+					i++
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkCacheParallelMixed 90% чтений и 10% записей, как у трекера под нагрузкой
+func BenchmarkCacheParallelMixed(b *testing.B) {
+	keys := benchKeyNames()
+	for name, newCache := range benchCaches() {
+		b.Run(name, func(b *testing.B) {
+			c := newCache()
+			defer c.Close()
+			for i, key := range keys {
+				c.Set(key, i, time.Minute)
+			}
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					key := keys[i%benchKeys]
+					if i%10 == 0 {
+						c.Set(key, i, time.Minute)
+					} else {
+						c.Get(key)
+					}
+					i++
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkCacheGetDuringExpiry чтения на фоне очистки большого кэша:
+// InMemoryCache держит блокировку на весь проход, ShardedCache - на одну порцию шарда
+func BenchmarkCacheGetDuringExpiry(b *testing.B) {
+	const entries = 20_000
+	keys := benchKeyNames()
+
+	b.Run("InMemory", func(b *testing.B) {
+		c := NewInMemoryCache[int]()
+		defer c.Close()
+		fillExpiring(c, entries, keys)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					c.store.removeExpired(time.Now(), 0)
+				}
+			}
+		}()
+
+		runParallelGet(b, c, keys)
+	})
+
+	b.Run("Sharded", func(b *testing.B) {
+		c := NewShardedCache(ShardedOptions[int]{})
+		defer c.Close()
+		fillExpiring(c, entries, keys)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			for i := 0; ; i++ {
+				select {
+				case <-stop:
+					return
+				default:
+					c.expireShard(c.shards[i%len(c.shards)])
+				}
+			}
+		}()
+
+		runParallelGet(b, c, keys)
+	})
+}
+
+// fillExpiring заполняет кэш горячими ключами и массой записей с долгим TTL,
+// которые очистке приходится просматривать
+func fillExpiring(c Cache[int], entries int, hot []string) {
+	for i, key := range hot {
+		c.Set(key, i, time.Hour)
+	}
+	for i := 0; i < entries; i++ {
+		c.Set("cold_"+strconv.Itoa(i), i, time.Hour)
+	}
+}
+
+func runParallelGet(b *testing.B, c Cache[int], keys []string) {
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			c.Get(keys[i%benchKeys])
+			i++
+		}
+	})
+}
@@ -15,9 +15,24 @@ type EnhancedCache struct {
 }
 
 func NewEnhancedCache() *EnhancedCache {
-    return &EnhancedCache{
-        cache: NewInMemoryCache[interface{}](),
+    return NewEnhancedCacheWithOptions(Options[interface{}]{})
+}
+
+// NewEnhancedCacheWithOptions создаёт кэш со статистикой поверх ограниченного кэша.
+// Счётчик evictions учитывает все записи, покинувшие кэш не по Delete
+func NewEnhancedCacheWithOptions(opts Options[interface{}]) *EnhancedCache {
+    ec := &EnhancedCache{}
+
+    userOnEvict := opts.OnEvict
+    opts.OnEvict = func(key string, value interface{}, reason EvictionReason) {
+        ec.evictions.Add(1)
+        if userOnEvict != nil {
+            userOnEvict(key, value, reason)
+        }
     }
+
+    ec.cache = NewInMemoryCacheWithOptions(opts)
+    return ec
 }
 
 func (ec *EnhancedCache) Get(key string) (interface{}, bool) {
@@ -30,10 +45,30 @@ func (ec *EnhancedCache) Get(key string) (interface{}, bool) {
     return value, ok
 }
 
+// GetOrLoad как Get, но при промахе загружает значение через loader
+func (ec *EnhancedCache) GetOrLoad(key string, ttl time.Duration, loader func() (interface{}, error)) (interface{}, error) {
+    loaded := false
+    value, err := ec.cache.GetOrLoad(key, ttl, func() (interface{}, error) {
+        loaded = true
+        return loader()
+    })
+    if loaded {
+        ec.misses.Add(1)
+    } else {
+        ec.hits.Add(1)
+    }
+    return value, err
+}
+
 func (ec *EnhancedCache) Set(key string, value interface{}, ttl time.Duration) {
     ec.cache.Set(key, value, ttl)
 }
 
+// Close останавливает фоновую очистку внутреннего кэша
+func (ec *EnhancedCache) Close() {
+    ec.cache.Close()
+}
+
 func (ec *EnhancedCache) GetStats() map[string]interface{} {
     hitRate := float64(0)
     total := ec.hits.Load() + ec.misses.Load()
@@ -41,11 +76,16 @@ func (ec *EnhancedCache) GetStats() map[string]interface{} {
         hitRate = float64(ec.hits.Load()) / float64(total) * 100
     }
     
+    cacheStats := ec.cache.Stats()
+
     return map[string]interface{}{
-        "hits":       ec.hits.Load(),
-        "misses":     ec.misses.Load(),
-        "hit_rate":   fmt.Sprintf("%.1f%%", hitRate),
-        "size":       ec.cache.Size(),
-        "evictions":  ec.evictions.Load(),
+        "hits":        ec.hits.Load(),
+        "misses":      ec.misses.Load(),
+        "hit_rate":    fmt.Sprintf("%.1f%%", hitRate),
+        "size":        cacheStats.Entries,
+        "evictions":   ec.evictions.Load(),
+        "capacity":    cacheStats.Capacity,
+        "expirations": cacheStats.Expirations,
+        "rejections":  cacheStats.Rejections,
     }
 }
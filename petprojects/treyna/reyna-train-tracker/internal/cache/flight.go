@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// PanicError паника загрузчика, которую получает каждый ожидающий вызов
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("cache loader panicked: %v\n\n%s", e.Value, e.Stack)
+}
+
+// flightCall один выполняющийся загрузчик и его результат
+type flightCall[T any] struct {
+	done     chan struct{}
+	value    T
+	err      error
+	panicErr *PanicError
+}
+
+// flightGroup объединяет одновременные загрузки одного ключа
+// Паттерн: SingleFlight - первый промах запускает загрузку,
+// остальные ждут её результат вместо повторного вычисления
+type flightGroup[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall[T]
+}
+
+// do выполняет fn один раз для всех одновременных вызовов с этим ключом.
+// Ошибка fn возвращается всем ожидающим, паника - перевыбрасывается у каждого
+func (g *flightGroup[T]) do(key string, fn func() (T, error)) (T, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall[T])
+	}
+	if call, found := g.calls[key]; found {
+		g.mu.Unlock()
+		return call.wait()
+	}
+
+	call := &flightCall[T]{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	func() {
+		defer func() {
+			if v := recover(); v != nil {
+				call.panicErr = &PanicError{Value: v, Stack: debug.Stack()}
+			}
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+
+			close(call.done)
+		}()
+
+		call.value, call.err = fn()
+	}()
+
+	return call.wait()
+}
+
+func (c *flightCall[T]) wait() (T, error) {
+	<-c.done
+	if c.panicErr != nil {
+		panic(c.panicErr)
+	}
+	return c.value, c.err
+}
+
+// getOrLoad реализация cache-aside: значение из кэша или из загрузчика,
+// одновременные промахи по одному ключу разделяют одну загрузку.
+// Ошибки загрузчика не кэшируются
+func getOrLoad[T any](c Cache[T], g *flightGroup[T], key string, ttl time.Duration, loader func() (T, error)) (T, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	return g.do(key, func() (T, error) {
+		// Между промахом и стартом загрузки значение мог положить предыдущий загрузчик
+		if value, ok := c.Get(key); ok {
+			return value, nil
+		}
+
+		value, err := loader()
+		if err != nil {
+			return value, err
+		}
+
+		c.Set(key, value, ttl)
+		return value, nil
+	})
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestGetOrLoadCoalesces одновременные промахи по ключу разделяют одну загрузку
+func TestGetOrLoadCoalesces(t *testing.T) {
+	errLoad := errors.New("load failed")
+	caches := map[string]func() Cache[int]{
+		"in-memory": func() Cache[int] { return NewInMemoryCache[int]() },
+		"sharded":   func() Cache[int] { return NewShardedCache(ShardedOptions[int]{Shards: 4}) },
+	}
+	tests := []struct {
+		name      string
+		keys      int
+		loadErr   error
+		panics    bool
+		wantLoads int32 // Загрузок после повторного вызова по каждому ключу
+	}{
+		{name: "one key", keys: 1, wantLoads: 1},
+		{name: "keys load separately", keys: 3, wantLoads: 3},
+		{name: "errors are shared but not cached", keys: 1, loadErr: errLoad, wantLoads: 2},
+		{name: "panic reaches every caller", keys: 1, panics: true, wantLoads: 2},
+	}
+	for cacheName, newCache := range caches {
+		for _, tt := range tests {
+			t.Run(cacheName+"/"+tt.name, func(t *testing.T) {
+				c := newCache()
+				defer c.Close()
+
+				var loads atomic.Int32
+				release := make(chan struct{})
+				loader := func(key int) func() (int, error) {
+					return func() (int, error) {
+						loads.Add(1)
+						<-release
+						if tt.panics {
+							panic("boom")
+						}
+						return key, tt.loadErr
+					}
+				}
+				call := func(key int) (value int, err error) {
+					defer func() {
+						if v := recover(); v != nil {
+							panicErr, ok := v.(*PanicError)
+							if !ok {
+								panic(v)
+							}
+							err = panicErr
+						}
+					}()
+					return c.GetOrLoad(fmt.Sprintf("key-%d", key), time.Minute, loader(key))
+				}
+
+				const callers = 20
+				var started, done sync.WaitGroup
+				errs := make(chan error, callers*tt.keys)
+				for key := range tt.keys {
+					for range callers {
+						started.Add(1)
+						done.Add(1)
+						go func() {
+							defer done.Done()
+							started.Done()
+							value, err := call(key)
+							if err == nil && value != key {
+								err = fmt.Errorf("key %d: got %d", key, value)
+							}
+							errs <- err
+						}()
+					}
+				}
+				started.Wait()
+				time.Sleep(20 * time.Millisecond) // Все вызовы успевают встать в ожидание загрузки
+				close(release)
+				done.Wait()
+				close(errs)
+
+				for err := range errs {
+					switch {
+					case tt.panics:
+						var panicErr *PanicError
+						if !errors.As(err, &panicErr) {
+							t.Fatalf("expected the loader panic, got %v", err)
+						}
+					case !errors.Is(err, tt.loadErr):
+						t.Fatalf("expected %v, got %v", tt.loadErr, err)
+					}
+				}
+				if got := loads.Load(); got != int32(tt.keys) {
+					t.Fatalf("%d concurrent callers per key: %d loads, want %d", callers, got, tt.keys)
+				}
+
+				for key := range tt.keys {
+					call(key)
+				}
+				if got := loads.Load(); got != tt.wantLoads {
+					t.Errorf("after a repeated call: %d loads, want %d", got, tt.wantLoads)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/models"
+)
+
+// snapshotVersion версия формата файла снимка
+const snapshotVersion = 1
+
+// Snapshotter кэш, содержимое которого можно сохранить и восстановить вместе с TTL
+type Snapshotter[T any] interface {
+	StatsCache[T]
+	Entries() map[string]models.CacheEntry[T]
+	SetEntry(key string, entry models.CacheEntry[T])
+	// Now текущее время по часам кэша: по нему отсекаются истёкшие записи снимка
+	Now() time.Time
+	// Clock часы кэша: по ним идут периодические снимки
+	Clock() clock.Clock
+}
+
+// snapshotFile содержимое файла снимка (gob).
+// Если T - interface{}, конкретные типы значений нужно зарегистрировать через gob.Register
+type snapshotFile[T any] struct {
+	Version int
+	SavedAt time.Time
+	Entries map[string]models.CacheEntry[T]
+}
+
+// SaveSnapshot сохраняет неистёкшие записи кэша в файл.
+// Запись атомарная: сначала во временный файл, затем rename
+func SaveSnapshot[T any](c Snapshotter[T], path string) error {
+	file := snapshotFile[T]{
+		Version: snapshotVersion,
+		SavedAt: c.Now(),
+		Entries: c.Entries(),
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := gob.NewEncoder(tmp).Encode(file); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot восстанавливает из файла записи, чей TTL ещё не истёк.
+// Возвращает количество восстановленных записей; отсутствие файла - не ошибка
+func LoadSnapshot[T any](c Snapshotter[T], path string) (int, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer f.Close()
+
+	var file snapshotFile[T]
+	if err := gob.NewDecoder(f).Decode(&file); err != nil {
+		return 0, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	if file.Version != snapshotVersion {
+		return 0, fmt.Errorf("unsupported snapshot version: %d", file.Version)
+	}
+
+	restored := 0
+	now := c.Now()
+	for key, entry := range file.Entries {
+		if entry.Expired(now) {
+			continue
+		}
+		c.SetEntry(key, entry)
+		restored++
+	}
+	return restored, nil
+}
+
+// FileBackedCache кэш, который переживает перезапуск:
+// при создании загружает снимок с диска, периодически сохраняет его
+// и делает финальный снимок при Close
+// Паттерн: Decorator над любым Snapshotter
+type FileBackedCache[T any] struct {
+	Snapshotter[T]
+
+	path   string
+	saveMu sync.Mutex // Не даёт двум сохранениям писать файл одновременно
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewFileBackedCache оборачивает кэш и восстанавливает его из path.
+// interval <= 0 отключает периодические снимки (останется только снимок при Close)
+func NewFileBackedCache[T any](inner Snapshotter[T], path string, interval time.Duration) (*FileBackedCache[T], int, error) {
+	restored, err := LoadSnapshot(inner, path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c := &FileBackedCache[T]{
+		Snapshotter: inner,
+		path:        path,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+
+	// Тикер создаём до запуска горутины: сдвиг фальшивых часов сразу после
+	// конструктора не должен проскочить мимо него
+	var ticker clock.Ticker
+	if interval > 0 {
+		ticker = inner.Clock().NewTicker(interval)
+	}
+	go c.snapshotLoop(ticker)
+
+	return c, restored, nil
+}
+
+// Save сохраняет снимок прямо сейчас
+func (c *FileBackedCache[T]) Save() error {
+	c.saveMu.Lock()
+	defer c.saveMu.Unlock()
+
+	return SaveSnapshot(c.Snapshotter, c.path)
+}
+
+// Close останавливает периодические снимки, сохраняет финальный
+// и закрывает внутренний кэш
+func (c *FileBackedCache[T]) Close() {
+	c.closeOnce.Do(func() {
+		close(c.stop)
+		<-c.done
+
+		if err := c.Save(); err != nil {
+			fmt.Printf("⚠️  Не удалось сохранить снимок кэша %s: %v\n", c.path, err)
+		}
+		c.Snapshotter.Close()
+	})
+}
+
+// snapshotLoop сохраняет снимок по тикеру часов кэша; ticker nil - только ждёт Close
+func (c *FileBackedCache[T]) snapshotLoop(ticker clock.Ticker) {
+	defer close(c.done)
+
+	if ticker == nil {
+		<-c.stop
+		return
+	}
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C():
+			if err := c.Save(); err != nil {
+				fmt.Printf("⚠️  Не удалось сохранить снимок кэша %s: %v\n", c.path, err)
+			}
+		}
+	}
+}
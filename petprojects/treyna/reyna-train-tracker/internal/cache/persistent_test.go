@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"encoding/gob"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/clock"
+)
+
+// TestSnapshotSurvivesRestart после перезапуска восстанавливаются только записи
+// с неистёкшим TTL, и истекают они в тот же момент, что и до перезапуска
+func TestSnapshotSurvivesRestart(t *testing.T) {
+	inners := map[string]func(clk clock.Clock) Snapshotter[string]{
+		"in-memory": func(clk clock.Clock) Snapshotter[string] {
+			return NewInMemoryCacheWithOptions(Options[string]{Clock: clk})
+		},
+		"sharded": func(clk clock.Clock) Snapshotter[string] {
+			return NewShardedCache(ShardedOptions[string]{Options: Options[string]{Clock: clk}, Shards: 4})
+		},
+	}
+	tests := []struct {
+		name     string
+		downtime time.Duration
+		want     []string
+	}{
+		{"quick restart", time.Second, []string{"hour", "minute"}},
+		{"short entries expired", 2 * time.Minute, []string{"hour"}},
+		{"everything expired", 2 * time.Hour, nil},
+	}
+	for innerName, newInner := range inners {
+		for _, tt := range tests {
+			t.Run(innerName+"/"+tt.name, func(t *testing.T) {
+				start := time.Date(2025, 10, 12, 20, 30, 0, 0, time.UTC)
+				clk := clock.NewFake(start)
+				path := filepath.Join(t.TempDir(), "cache.gob")
+
+				before, restored, err := NewFileBackedCache(newInner(clk), path, 0)
+				if err != nil || restored != 0 {
+					t.Fatalf("missing snapshot must not fail: %d, %v", restored, err)
+				}
+				before.Set("minute", "m", time.Minute)
+				before.Set("hour", "h", time.Hour)
+				before.Close() // Финальный снимок
+
+				clk.Advance(tt.downtime)
+				after, restored, err := NewFileBackedCache(newInner(clk), path, 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer after.Close()
+
+				var keys []string
+				for key := range after.Entries() {
+					keys = append(keys, key)
+				}
+				slices.Sort(keys)
+				if restored != len(tt.want) || !slices.Equal(keys, tt.want) {
+					t.Fatalf("restored %d %v, want %v", restored, keys, tt.want)
+				}
+
+				// TTL отсчитывается от исходной записи, а не от перезапуска
+				clk.Set(start.Add(time.Hour + time.Second))
+				if _, ok := after.Get("hour"); ok {
+					t.Error("restored entry outlived its original TTL")
+				}
+			})
+		}
+	}
+}
+
+func TestLoadSnapshotRejectsCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.gob")
+	if err := os.WriteFile(path, []byte("not a snapshot"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c := NewInMemoryCache[string]()
+	defer c.Close()
+	if _, err := LoadSnapshot[string](c, path); err == nil {
+		t.Error("corrupt snapshot must be reported")
+	}
+}
+
+// TestPeriodicSnapshotFollowsCacheClock периодический снимок идёт по часам кэша:
+// фальшивые часы запускают его сдвигом, а время снимка берётся с них же
+func TestPeriodicSnapshotFollowsCacheClock(t *testing.T) {
+	start := time.Date(2025, 10, 12, 20, 30, 0, 0, time.UTC)
+	clk := clock.NewFake(start)
+	path := filepath.Join(t.TempDir(), "cache.gob")
+
+	c, _, err := NewFileBackedCache[string](NewInMemoryCacheWithOptions(Options[string]{Clock: clk}), path, 5*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.Set("key", "value", time.Hour)
+
+	clk.Advance(4 * time.Minute)
+	time.Sleep(20 * time.Millisecond)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("snapshot saved before the interval: %v", err)
+	}
+
+	clk.Advance(time.Minute)
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if file, err := readSnapshot(path); err == nil {
+			if !file.SavedAt.Equal(start.Add(5*time.Minute)) || len(file.Entries) != 1 {
+				t.Errorf("snapshot saved at %v with %d entries", file.SavedAt, len(file.Entries))
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("no snapshot after the fake clock passed the interval")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func readSnapshot(path string) (snapshotFile[string], error) {
+	var file snapshotFile[string]
+	f, err := os.Open(path)
+	if err != nil {
+		return file, err
+	}
+	defer f.Close()
+	err = gob.NewDecoder(f).Decode(&file)
+	return file, err
+}
@@ -0,0 +1,306 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"hash/maphash"
+	"strings"
+)
+
+// EvictionPolicy решает, какую запись вытеснить при переполнении кэша.
+// Методы вызываются кэшем под его блокировкой, поэтому сама политика
+// может не заботиться о синхронизации
+type EvictionPolicy interface {
+	// OnAdd вызывается при добавлении нового ключа
+	OnAdd(key string)
+	// OnAccess вызывается при чтении или перезаписи ключа.
+	// Для неизвестного ключа ничего не делает
+	OnAccess(key string)
+	// OnRemove вызывается при удалении ключа из кэша
+	OnRemove(key string)
+	// Victim возвращает кандидата на вытеснение
+	Victim() (string, bool)
+}
+
+// AdmissionPolicy политика, которая может отказать новой записи,
+// если она ценнее вытесняемой не окажется (TinyLFU)
+type AdmissionPolicy interface {
+	Admit(candidate, victim string) bool
+}
+
+// Названия политик для конфигурации
+const (
+	PolicyLRU     = "lru"
+	PolicyLFU     = "lfu"
+	PolicyTinyLFU = "tinylfu"
+)
+
+// NewPolicy создаёт политику вытеснения по названию из конфигурации
+func NewPolicy(name string, capacity int) (EvictionPolicy, error) {
+	factory, err := PolicyFactory(name)
+	if err != nil {
+		return nil, err
+	}
+	return factory(capacity), nil
+}
+
+// PolicyFactory возвращает конструктор политики по названию.
+// Нужен шардированному кэшу: у каждого шарда своя политика
+func PolicyFactory(name string) (func(capacity int) EvictionPolicy, error) {
+	switch strings.ToLower(name) {
+	case PolicyLRU, "":
+		return func(int) EvictionPolicy { return NewLRUPolicy() }, nil
+	case PolicyLFU:
+		return func(int) EvictionPolicy { return NewLFUPolicy() }, nil
+	case PolicyTinyLFU:
+		return func(capacity int) EvictionPolicy { return NewTinyLFUPolicy(capacity) }, nil
+	default:
+		return nil, fmt.Errorf("unknown eviction policy: %s", name)
+	}
+}
+
+// LRUPolicy вытесняет запись, к которой дольше всего не обращались
+// Паттерн: двусвязный список + hash map, все операции O(1)
+type LRUPolicy struct {
+	order *list.List // Начало списка - самые свежие ключи
+	items map[string]*list.Element
+}
+
+// NewLRUPolicy создаёт LRU политику
+func NewLRUPolicy() *LRUPolicy {
+	return &LRUPolicy{
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+func (p *LRUPolicy) OnAdd(key string) {
+	if elem, ok := p.items[key]; ok {
+		p.order.MoveToFront(elem)
+		return
+	}
+	p.items[key] = p.order.PushFront(key)
+}
+
+func (p *LRUPolicy) OnAccess(key string) {
+	if elem, ok := p.items[key]; ok {
+		p.order.MoveToFront(elem)
+	}
+}
+
+func (p *LRUPolicy) OnRemove(key string) {
+	if elem, ok := p.items[key]; ok {
+		p.order.Remove(elem)
+		delete(p.items, key)
+	}
+}
+
+func (p *LRUPolicy) Victim() (string, bool) {
+	back := p.order.Back()
+	if back == nil {
+		return "", false
+	}
+	return back.Value.(string), true
+}
+
+// LFUPolicy вытесняет наименее часто используемую запись,
+// при равной частоте - самую старую из них.
+// Паттерн: списки ключей по частотам + указатель на минимальную частоту, O(1)
+type LFUPolicy struct {
+	items   map[string]*list.Element
+	buckets map[uint64]*list.List // частота -> ключи (начало списка - свежие)
+	minFreq uint64
+}
+
+type lfuItem struct {
+	key  string
+	freq uint64
+}
+
+// NewLFUPolicy создаёт LFU политику
+func NewLFUPolicy() *LFUPolicy {
+	return &LFUPolicy{
+		items:   make(map[string]*list.Element),
+		buckets: make(map[uint64]*list.List),
+	}
+}
+
+func (p *LFUPolicy) OnAdd(key string) {
+	if _, ok := p.items[key]; ok {
+		p.OnAccess(key)
+		return
+	}
+	p.items[key] = p.bucket(1).PushFront(&lfuItem{key: key, freq: 1})
+	p.minFreq = 1
+}
+
+func (p *LFUPolicy) OnAccess(key string) {
+	elem, ok := p.items[key]
+	if !ok {
+		return
+	}
+
+	item := elem.Value.(*lfuItem)
+	p.unlink(elem, item.freq)
+	item.freq++
+	p.items[key] = p.bucket(item.freq).PushFront(item)
+}
+
+func (p *LFUPolicy) OnRemove(key string) {
+	elem, ok := p.items[key]
+	if !ok {
+		return
+	}
+	p.unlink(elem, elem.Value.(*lfuItem).freq)
+	delete(p.items, key)
+}
+
+func (p *LFUPolicy) Victim() (string, bool) {
+	if len(p.items) == 0 {
+		return "", false
+	}
+	bucket, ok := p.buckets[p.minFreq]
+	if !ok {
+		// minFreq устарел после удаления - ищем заново
+		p.minFreq = 0
+		for freq := range p.buckets {
+			if p.minFreq == 0 || freq < p.minFreq {
+				p.minFreq = freq
+			}
+		}
+		bucket = p.buckets[p.minFreq]
+	}
+	return bucket.Back().Value.(*lfuItem).key, true
+}
+
+func (p *LFUPolicy) bucket(freq uint64) *list.List {
+	bucket, ok := p.buckets[freq]
+	if !ok {
+		bucket = list.New()
+		p.buckets[freq] = bucket
+	}
+	return bucket
+}
+
+func (p *LFUPolicy) unlink(elem *list.Element, freq uint64) {
+	bucket := p.buckets[freq]
+	bucket.Remove(elem)
+	if bucket.Len() == 0 {
+		delete(p.buckets, freq)
+		if p.minFreq == freq {
+			p.minFreq = freq + 1
+		}
+	}
+}
+
+// TinyLFUPolicy LRU с фильтром допуска по частоте (TinyLFU):
+// новая запись вытесняет старую, только если её оценочная частота выше.
+// Частоты хранятся приближённо в Count-Min Sketch и периодически
+// уменьшаются вдвое, чтобы кэш адаптировался к смене нагрузки
+type TinyLFUPolicy struct {
+	lru    *LRUPolicy
+	sketch *countMinSketch
+}
+
+// NewTinyLFUPolicy создаёт TinyLFU политику для кэша заданной вместимости
+func NewTinyLFUPolicy(capacity int) *TinyLFUPolicy {
+	return &TinyLFUPolicy{
+		lru:    NewLRUPolicy(),
+		sketch: newCountMinSketch(capacity),
+	}
+}
+
+func (p *TinyLFUPolicy) OnAdd(key string) {
+	p.sketch.Increment(key)
+	p.lru.OnAdd(key)
+}
+
+func (p *TinyLFUPolicy) OnAccess(key string) {
+	p.sketch.Increment(key)
+	p.lru.OnAccess(key)
+}
+
+func (p *TinyLFUPolicy) OnRemove(key string) {
+	p.lru.OnRemove(key)
+}
+
+func (p *TinyLFUPolicy) Victim() (string, bool) {
+	return p.lru.Victim()
+}
+
+// Admit учитывает обращение к кандидату и сравнивает его частоту с жертвой
+func (p *TinyLFUPolicy) Admit(candidate, victim string) bool {
+	p.sketch.Increment(candidate)
+	return p.sketch.Estimate(candidate) > p.sketch.Estimate(victim)
+}
+
+// countMinSketch приближённый счётчик частот с фиксированной памятью
+type countMinSketch struct {
+	rows       [sketchDepth][]uint8
+	seeds      [sketchDepth]maphash.Seed
+	mask       uint64
+	additions  int
+	resetLimit int // После стольких инкрементов все счётчики делятся пополам
+}
+
+const (
+	sketchDepth      = 4
+	sketchMaxCounter = 15 // 4-битные счётчики, как в оригинальном TinyLFU
+)
+
+func newCountMinSketch(capacity int) *countMinSketch {
+	if capacity < 16 {
+		capacity = 16
+	}
+
+	// Ширина - ближайшая степень двойки, чтобы индекс брать маской
+	width := 1
+	for width < capacity {
+		width <<= 1
+	}
+
+	s := &countMinSketch{
+		mask:       uint64(width - 1),
+		resetLimit: capacity * 10,
+	}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, width)
+		s.seeds[i] = maphash.MakeSeed()
+	}
+	return s
+}
+
+func (s *countMinSketch) Increment(key string) {
+	for i := range s.rows {
+		idx := maphash.String(s.seeds[i], key) & s.mask
+		if s.rows[i][idx] < sketchMaxCounter {
+			s.rows[i][idx]++
+		}
+	}
+
+	s.additions++
+	if s.additions >= s.resetLimit {
+		s.reset()
+	}
+}
+
+func (s *countMinSketch) Estimate(key string) uint8 {
+	estimate := uint8(sketchMaxCounter)
+	for i := range s.rows {
+		idx := maphash.String(s.seeds[i], key) & s.mask
+		if s.rows[i][idx] < estimate {
+			estimate = s.rows[i][idx]
+		}
+	}
+	return estimate
+}
+
+// reset "старит" частоты, деля все счётчики пополам
+func (s *countMinSketch) reset() {
+	for i := range s.rows {
+		for j := range s.rows[i] {
+			s.rows[i][j] >>= 1
+		}
+	}
+	s.additions /= 2
+}
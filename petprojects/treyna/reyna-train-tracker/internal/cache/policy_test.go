@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestEvictionOrder порядок вытеснения для каждой политики.
+// Операции: "+k" - Set, "?k" - Get
+func TestEvictionOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       EvictionPolicy
+		ops          []string
+		wantEvicted  []string
+		wantRejected []string
+		wantKeys     []string
+	}{
+		{
+			name:        "lru evicts least recently used",
+			policy:      NewLRUPolicy(),
+			ops:         []string{"+a", "+b", "+c", "?a", "+d", "+e"},
+			wantEvicted: []string{"b", "c"},
+			wantKeys:    []string{"a", "d", "e"},
+		},
+		{
+			name:        "lru overwrite counts as access",
+			policy:      NewLRUPolicy(),
+			ops:         []string{"+a", "+b", "+c", "+a", "+d"},
+			wantEvicted: []string{"b"},
+			wantKeys:    []string{"a", "c", "d"},
+		},
+		{
+			name:        "lfu evicts least frequently used",
+			policy:      NewLFUPolicy(),
+			ops:         []string{"+a", "+b", "+c", "?a", "?a", "?b", "+d", "+e"},
+			wantEvicted: []string{"c", "d"},
+			wantKeys:    []string{"a", "b", "e"},
+		},
+		{
+			name:        "lfu ties go to the oldest key",
+			policy:      NewLFUPolicy(),
+			ops:         []string{"+a", "+b", "+c", "+d"},
+			wantEvicted: []string{"a"},
+			wantKeys:    []string{"b", "c", "d"},
+		},
+		{
+			name:         "tinylfu rejects a rare newcomer",
+			policy:       NewTinyLFUPolicy(3),
+			ops:          []string{"+a", "+b", "+c", "?a", "?b", "?c", "+d"},
+			wantRejected: []string{"d"},
+			wantKeys:     []string{"a", "b", "c"},
+		},
+		{
+			name:         "tinylfu admits a newcomer once it is popular",
+			policy:       NewTinyLFUPolicy(3),
+			ops:          []string{"+a", "+b", "+c", "+d", "+d"},
+			wantEvicted:  []string{"a"},
+			wantRejected: []string{"d"},
+			wantKeys:     []string{"b", "c", "d"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted, rejected []string
+			c := NewInMemoryCacheWithOptions(Options[int]{
+				Capacity: 3,
+				Policy:   tt.policy,
+				OnEvict: func(key string, _ int, reason EvictionReason) {
+					switch reason {
+					case EvictionCapacity:
+						evicted = append(evicted, key)
+					case EvictionRejected:
+						rejected = append(rejected, key)
+					}
+				},
+			})
+			defer c.Close()
+
+			for _, op := range tt.ops {
+				if op[0] == '+' {
+					c.Set(op[1:], 1, time.Hour)
+				} else {
+					c.Get(op[1:])
+				}
+			}
+
+			if !slices.Equal(evicted, tt.wantEvicted) || !slices.Equal(rejected, tt.wantRejected) {
+				t.Errorf("evicted %v rejected %v, want %v and %v", evicted, rejected, tt.wantEvicted, tt.wantRejected)
+			}
+			keys := make([]string, 0, c.Size())
+			for key := range c.Entries() {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			if !slices.Equal(keys, tt.wantKeys) {
+				t.Errorf("keys %v, want %v", keys, tt.wantKeys)
+			}
+			stats := c.Stats()
+			if stats.Evictions != uint64(len(tt.wantEvicted)) || stats.Rejections != uint64(len(tt.wantRejected)) {
+				t.Errorf("unexpected stats: %+v", stats)
+			}
+		})
+	}
+}
+
+func TestSizeEstimatorCapacity(t *testing.T) {
+	var evicted []string
+	c := NewInMemoryCacheWithOptions(Options[string]{
+		Capacity:      10,
+		SizeEstimator: func(_ string, value string) int { return len(value) },
+		OnEvict:       func(key string, _ string, _ EvictionReason) { evicted = append(evicted, key) },
+	})
+	defer c.Close()
+
+	c.Set("a", "aaaa", time.Hour)
+	c.Set("b", "bbbb", time.Hour)
+	c.Set("c", "cccc", time.Hour)          // 12 > 10: вытесняется a
+	c.Set("big", "xxxxxxxxxxx", time.Hour) // Больше всего кэша: отклоняется сразу
+
+	if stats := c.Stats(); stats.Size != 8 || stats.Entries != 2 || !slices.Equal(evicted, []string{"a", "big"}) {
+		t.Errorf("stats %+v, evicted %v", stats, evicted)
+	}
+}
@@ -0,0 +1,235 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"reyna-train-tracker/internal/models"
+)
+
+// shard хранилище записей под одной блокировкой.
+// InMemoryCache состоит из одного шарда, ShardedCache - из нескольких
+type shard[T any] struct {
+	data map[string]models.CacheEntry[T]
+	mu   sync.RWMutex // RWMutex позволяет множественное чтение, но эксклюзивную запись
+
+	capacity int
+	size     int
+	policy   EvictionPolicy
+	policyMu sync.Mutex // Политика меняет состояние и при чтении, поэтому у неё свой мьютекс
+
+	counters *counters
+}
+
+// counters общие счётчики вытеснений для всех шардов кэша
+type counters struct {
+	evictions   atomic.Uint64
+	expirations atomic.Uint64
+	rejections  atomic.Uint64
+}
+
+// evicted запись, удалённая под блокировкой; колбэк вызывается после неё
+type evicted[T any] struct {
+	key    string
+	value  T
+	reason EvictionReason
+}
+
+func newShard[T any](capacity int, policy EvictionPolicy, c *counters) *shard[T] {
+	s := &shard[T]{
+		data:     make(map[string]models.CacheEntry[T]),
+		capacity: capacity,
+		counters: c,
+	}
+	if capacity > 0 {
+		s.policy = policy
+		if s.policy == nil {
+			s.policy = NewLRUPolicy()
+		}
+	}
+	return s
+}
+
+// set вставляет запись и возвращает вытесненные ради неё записи
+func (s *shard[T]) set(key string, entry models.CacheEntry[T]) []evicted[T] {
+	s.mu.Lock() // Эксклюзивная блокировка для записи
+	defer s.mu.Unlock()
+
+	if s.policy == nil {
+		if old, ok := s.data[key]; ok {
+			s.size -= old.Size
+		}
+		s.data[key] = entry
+		s.size += entry.Size
+		return nil
+	}
+
+	s.policyMu.Lock()
+	defer s.policyMu.Unlock()
+
+	// Запись больше всего кэша сохранить нельзя
+	if entry.Size > s.capacity {
+		s.counters.rejections.Add(1)
+		return []evicted[T]{{key: key, value: entry.Value, reason: EvictionRejected}}
+	}
+
+	old, exists := s.data[key]
+	if exists {
+		s.size -= old.Size
+	}
+
+	var removed []evicted[T]
+	admissionChecked := exists
+	detached := false
+	for s.size+entry.Size > s.capacity {
+		victim, ok := s.policy.Victim()
+		if !ok {
+			break
+		}
+		if victim == key {
+			// Перезаписываемый ключ сам оказался жертвой - ищем другую,
+			// временно исключив его из политики
+			s.policy.OnRemove(key)
+			detached = true
+			continue
+		}
+
+		if !admissionChecked {
+			admissionChecked = true
+			if admission, ok := s.policy.(AdmissionPolicy); ok && !admission.Admit(key, victim) {
+				s.counters.rejections.Add(1)
+				return append(removed, evicted[T]{key: key, value: entry.Value, reason: EvictionRejected})
+			}
+		}
+
+		victimEntry := s.data[victim]
+		s.removeLocked(victim)
+		s.counters.evictions.Add(1)
+		removed = append(removed, evicted[T]{key: victim, value: victimEntry.Value, reason: EvictionCapacity})
+	}
+
+	s.data[key] = entry
+	s.size += entry.Size
+	if exists && !detached {
+		s.policy.OnAccess(key)
+	} else {
+		s.policy.OnAdd(key)
+	}
+
+	return removed
+}
+
+// get возвращает неистёкшую запись
+func (s *shard[T]) get(key string, now time.Time) (T, bool) {
+	s.mu.RLock() // Разделяемая блокировка для чтения
+	entry, ok := s.data[key]
+	s.mu.RUnlock()
+
+	if !ok || entry.Expired(now) {
+		var zero T
+		return zero, false
+	}
+
+	if s.policy != nil {
+		s.policyMu.Lock()
+		s.policy.OnAccess(key)
+		s.policyMu.Unlock()
+	}
+
+	return entry.Value, true
+}
+
+func (s *shard[T]) delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.data[key]; !ok {
+		return
+	}
+
+	if s.policy != nil {
+		s.policyMu.Lock()
+		defer s.policyMu.Unlock()
+	}
+	s.removeLocked(key)
+}
+
+func (s *shard[T]) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.policy != nil {
+		s.policyMu.Lock()
+		for key := range s.data {
+			s.policy.OnRemove(key)
+		}
+		s.policyMu.Unlock()
+	}
+
+	s.data = make(map[string]models.CacheEntry[T])
+	s.size = 0
+}
+
+// removeLocked удаляет запись; вызывается под s.mu (и s.policyMu, если есть политика)
+func (s *shard[T]) removeLocked(key string) {
+	entry, ok := s.data[key]
+	if !ok {
+		return
+	}
+
+	delete(s.data, key)
+	s.size -= entry.Size
+	if s.policy != nil {
+		s.policy.OnRemove(key)
+	}
+}
+
+// removeExpired удаляет записи с истёкшим TTL, просмотрев не больше limit записей
+// (limit <= 0 - весь шард). Возвращает удалённые записи и число просмотренных
+func (s *shard[T]) removeExpired(now time.Time, limit int) ([]evicted[T], int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.policy != nil {
+		s.policyMu.Lock()
+		defer s.policyMu.Unlock()
+	}
+
+	var removed []evicted[T]
+	scanned := 0
+	// Порядок обхода map случаен, поэтому ограниченный обход - это случайная выборка
+	for key, entry := range s.data {
+		if limit > 0 && scanned >= limit {
+			break
+		}
+		scanned++
+
+		if entry.Expired(now) {
+			s.removeLocked(key)
+			s.counters.expirations.Add(1)
+			removed = append(removed, evicted[T]{key: key, value: entry.Value, reason: EvictionExpired})
+		}
+	}
+	return removed, scanned
+}
+
+// usage возвращает количество записей и их суммарный размер
+func (s *shard[T]) usage() (int, int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.data), s.size
+}
+
+// copyEntries копирует неистёкшие записи шарда в dst
+func (s *shard[T]) copyEntries(dst map[string]models.CacheEntry[T], now time.Time) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for key, entry := range s.data {
+		if !entry.Expired(now) {
+			dst[key] = entry
+		}
+	}
+}
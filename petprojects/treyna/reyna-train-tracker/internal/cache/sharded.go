@@ -0,0 +1,242 @@
+package cache
+
+import (
+	"hash/maphash"
+	"sync"
+	"time"
+
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/models"
+)
+
+// Параметры инкрементальной очистки по умолчанию
+const (
+	defaultShardCount      = 32
+	defaultCleanupInterval = 1 * time.Minute
+	expireSampleSize       = 64 // Сколько записей шарда просматриваем за один шаг
+	expireRepeatRatio      = 4  // Повторяем шаг, если истёк больше чем каждый 4-й просмотренный
+)
+
+// ShardedOptions настройки шардированного кэша
+type ShardedOptions[T any] struct {
+	// Options общие настройки. Capacity делится между шардами (остаток - первым),
+	// поле Policy не используется - у каждого шарда своя политика из NewPolicy
+	Options[T]
+	// Shards количество шардов (округляется вверх до степени двойки)
+	Shards int
+	// NewPolicy создаёт политику для шарда заданной вместимости, по умолчанию LRU
+	NewPolicy func(capacity int) EvictionPolicy
+	// CleanupInterval за это время очистка обходит все шарды по одному разу
+	CleanupInterval time.Duration
+}
+
+// ShardedCache кэш, разбитый на независимые шарды со своими RWMutex
+// Паттерн: Lock striping - ключ по хэшу попадает в свой шард,
+// поэтому запросы к разным шардам не блокируют друг друга.
+// Очистка по TTL идёт инкрементально: за один тик блокируется только
+// один шард и просматривается ограниченная выборка записей
+type ShardedCache[T any] struct {
+	shards   []*shard[T]
+	mask     uint64
+	seed     maphash.Seed
+	counters counters
+	flights  flightGroup[T]
+	sizeOf   func(key string, value T) int
+	onEvict  func(key string, value T, reason EvictionReason)
+	interval time.Duration
+	clock    clock.Clock
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewShardedCache создаёт шардированный кэш
+func NewShardedCache[T any](opts ShardedOptions[T]) *ShardedCache[T] {
+	count := 1
+	for count < max(opts.Shards, 1) {
+		count <<= 1
+	}
+	if opts.Shards <= 0 {
+		count = defaultShardCount
+	}
+
+	interval := opts.CleanupInterval
+	if interval <= 0 {
+		interval = defaultCleanupInterval
+	}
+
+	c := &ShardedCache[T]{
+		shards:   make([]*shard[T], count),
+		mask:     uint64(count - 1),
+		seed:     maphash.MakeSeed(),
+		sizeOf:   opts.SizeEstimator,
+		onEvict:  opts.OnEvict,
+		interval: interval,
+		clock:    clockOrReal(opts.Clock),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	for i := range c.shards {
+		shardCapacity := splitCapacity(opts.Capacity, count, i)
+		var policy EvictionPolicy
+		if shardCapacity > 0 && opts.NewPolicy != nil {
+			policy = opts.NewPolicy(shardCapacity)
+		}
+		c.shards[i] = newShard[T](shardCapacity, policy, &c.counters)
+	}
+
+	go c.cleanupExpired()
+
+	return c
+}
+
+// splitCapacity вместимость шарда i из count: остаток от деления достаётся первым шардам,
+// чтобы в сумме получилась ровно capacity. Шард не бывает меньше одной записи
+// (0 означал бы "без ограничения"), поэтому при capacity < count сумма равна count
+func splitCapacity(capacity, count, i int) int {
+	if capacity <= 0 {
+		return 0
+	}
+	shardCapacity := capacity / count
+	if i < capacity%count {
+		shardCapacity++
+	}
+	return max(shardCapacity, 1)
+}
+
+func (c *ShardedCache[T]) shardFor(key string) *shard[T] {
+	return c.shards[maphash.String(c.seed, key)&c.mask]
+}
+
+// Set добавляет значение в шард ключа
+func (c *ShardedCache[T]) Set(key string, value T, ttl time.Duration) {
+	entry := models.CacheEntry[T]{
+		Value:     value,
+		Timestamp: c.clock.Now(),
+		TTL:       ttl,
+		Size:      entrySize(c.sizeOf, key, value),
+	}
+
+	notify(c.onEvict, c.shardFor(key).set(key, entry))
+}
+
+// Get получает значение, блокируя только шард ключа
+func (c *ShardedCache[T]) Get(key string) (T, bool) {
+	return c.shardFor(key).get(key, c.clock.Now())
+}
+
+// GetOrLoad возвращает значение из кэша или загружает его (cache-aside + SingleFlight)
+func (c *ShardedCache[T]) GetOrLoad(key string, ttl time.Duration, loader func() (T, error)) (T, error) {
+	return getOrLoad[T](c, &c.flights, key, ttl, loader)
+}
+
+// Delete удаляет значение из кэша
+func (c *ShardedCache[T]) Delete(key string) {
+	c.shardFor(key).delete(key)
+}
+
+// Clear очищает все шарды по очереди
+func (c *ShardedCache[T]) Clear() {
+	for _, s := range c.shards {
+		s.clear()
+	}
+}
+
+// Close останавливает горутину очистки. Повторные вызовы безопасны
+func (c *ShardedCache[T]) Close() {
+	c.closeOnce.Do(func() {
+		close(c.stop)
+	})
+	<-c.done
+}
+
+// cleanupExpired обходит шарды по одному: за CleanupInterval каждый
+// шард проверяется один раз, а не вся map разом
+func (c *ShardedCache[T]) cleanupExpired() {
+	defer close(c.done)
+
+	ticker := c.clock.NewTicker(max(c.interval/time.Duration(len(c.shards)), time.Millisecond))
+	defer ticker.Stop()
+
+	next := 0
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C():
+		}
+
+		c.expireShard(c.shards[next])
+		next = (next + 1) % len(c.shards)
+	}
+}
+
+// expireShard удаляет истёкшие записи шарда небольшими порциями
+// (как активная очистка в Redis): пока в выборке много истёкших,
+// берём следующую, отпуская блокировку между шагами
+func (c *ShardedCache[T]) expireShard(s *shard[T]) {
+	for {
+		removed, scanned := s.removeExpired(c.clock.Now(), expireSampleSize)
+		notify(c.onEvict, removed)
+
+		if scanned < expireSampleSize || len(removed)*expireRepeatRatio < scanned {
+			return
+		}
+
+		select {
+		case <-c.stop:
+			return
+		default:
+		}
+	}
+}
+
+// Size возвращает количество элементов во всех шардах
+func (c *ShardedCache[T]) Size() int {
+	total := 0
+	for _, s := range c.shards {
+		entries, _ := s.usage()
+		total += entries
+	}
+	return total
+}
+
+// Stats возвращает суммарный размер шардов и счётчики вытеснений
+func (c *ShardedCache[T]) Stats() Stats {
+	entries, size, capacity := 0, 0, 0
+	for _, s := range c.shards {
+		e, sz := s.usage()
+		entries += e
+		size += sz
+		capacity += s.capacity
+	}
+	return c.counters.snapshot(entries, size, capacity)
+}
+
+// Entries возвращает копию неистёкших записей всех шардов
+func (c *ShardedCache[T]) Entries() map[string]models.CacheEntry[T] {
+	entries := make(map[string]models.CacheEntry[T])
+	now := c.clock.Now()
+	for _, s := range c.shards {
+		s.copyEntries(entries, now)
+	}
+	return entries
+}
+
+// Now текущее время по часам кэша
+func (c *ShardedCache[T]) Now() time.Time {
+	return c.clock.Now()
+}
+
+// Clock часы кэша
+func (c *ShardedCache[T]) Clock() clock.Clock {
+	return c.clock
+}
+
+// SetEntry кладёт запись как есть, сохраняя её Timestamp и TTL
+func (c *ShardedCache[T]) SetEntry(key string, entry models.CacheEntry[T]) {
+	entry.Size = entrySize(c.sizeOf, key, entry.Value)
+	notify(c.onEvict, c.shardFor(key).set(key, entry))
+}
@@ -0,0 +1,28 @@
+package cache
+
+import "testing"
+
+func TestShardedCapacityMatchesConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		shards   int
+		want     int
+	}{
+		{"even split", 1024, 16, 1024},
+		{"remainder goes to first shards", 1000, 16, 1000},
+		{"one shard", 7, 1, 7},
+		{"fewer entries than shards", 5, 8, 8},
+		{"unbounded", 0, 16, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewShardedCache(ShardedOptions[int]{Options: Options[int]{Capacity: tt.capacity}, Shards: tt.shards})
+			defer c.Close()
+
+			if got := c.Stats().Capacity; got != tt.want {
+				t.Errorf("capacity %d over %d shards: got %d, want %d", tt.capacity, tt.shards, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package clock
+
+import "time"
+
+// Clock источник времени для трекера, обработчика, кэша и лимитера.
+// Паттерн: Dependency Injection - компоненты не зовут time.Now() напрямую,
+// поэтому момент поездки можно зафиксировать в тестах или ускорить в симуляции
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+	After(d time.Duration) <-chan time.Time
+	Sleep(d time.Duration)
+}
+
+// Ticker тикер, выданный часами
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// Real системные часы
+func Real() Clock {
+	return realClock{}
+}
+
+// New часы по настройкам: без start и с speed = 1 - системные,
+// иначе симуляция с момента start (или с текущего), идущая в speed раз быстрее
+func New(start time.Time, speed float64) Clock {
+	if start.IsZero() && (speed <= 0 || speed == 1) {
+		return Real()
+	}
+	if start.IsZero() {
+		start = time.Now()
+	}
+	return NewSimulated(start, speed)
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (realClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	t *time.Ticker
+}
+
+func (r realTicker) C() <-chan time.Time { return r.t.C }
+func (r realTicker) Stop()               { r.t.Stop() }
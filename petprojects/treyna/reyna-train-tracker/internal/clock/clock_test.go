@@ -0,0 +1,177 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+var tripStart = time.Date(2025, 10, 6, 22, 30, 0, 0, time.FixedZone("MSK", 3*3600))
+
+// fired сработал ли канал (без ожидания)
+func fired(ch <-chan time.Time) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestFakeAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		wait    time.Duration
+		advance []time.Duration
+		want    bool
+	}{
+		{"срок не наступил", time.Minute, []time.Duration{59 * time.Second}, false},
+		{"ровно в срок", time.Minute, []time.Duration{time.Minute}, true},
+		{"по частям", time.Minute, []time.Duration{30 * time.Second, 30 * time.Second}, true},
+		{"с запасом", time.Minute, []time.Duration{time.Hour}, true},
+		{"нулевое ожидание", 0, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := NewFake(tripStart)
+			ch := fake.After(tt.wait)
+			for _, d := range tt.advance {
+				fake.Advance(d)
+			}
+			if got := fired(ch); got != tt.want {
+				t.Errorf("fired = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFakeTicker(t *testing.T) {
+	fake := NewFake(tripStart)
+	ticker := fake.NewTicker(10 * time.Second)
+
+	steps := []struct {
+		advance time.Duration
+		want    bool
+	}{
+		{5 * time.Second, false},
+		{5 * time.Second, true},
+		{25 * time.Second, true}, // Пропущенные периоды дают один тик
+		{4 * time.Second, false},
+		{time.Second, true},
+	}
+	for i, step := range steps {
+		fake.Advance(step.advance)
+		if got := fired(ticker.C()); got != step.want {
+			t.Errorf("step %d (+%v): fired = %v, want %v", i, step.advance, got, step.want)
+		}
+	}
+
+	ticker.Stop()
+	fake.Advance(time.Hour)
+	if fired(ticker.C()) {
+		t.Error("stopped ticker fired")
+	}
+}
+
+func TestFakeSetAndSleep(t *testing.T) {
+	fake := NewFake(tripStart)
+
+	fake.Set(tripStart.Add(-time.Hour))
+	if !fake.Now().Equal(tripStart) {
+		t.Errorf("clock went backwards to %v", fake.Now())
+	}
+
+	woke := make(chan struct{})
+	go func() {
+		fake.Sleep(time.Hour)
+		close(woke)
+	}()
+
+	// Дожидаемся, пока Sleep зарегистрирует таймер
+	deadline := time.Now().Add(time.Second)
+	for {
+		fake.mu.Lock()
+		waiting := len(fake.waiters)
+		fake.mu.Unlock()
+		if waiting > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Sleep did not register a timer")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	fake.Set(tripStart.Add(time.Hour))
+	select {
+	case <-woke:
+	case <-time.After(time.Second):
+		t.Fatal("Sleep did not return after Set")
+	}
+	if !fake.Now().Equal(tripStart.Add(time.Hour)) {
+		t.Errorf("now = %v after Set", fake.Now())
+	}
+}
+
+func TestSimulatedScale(t *testing.T) {
+	tests := []struct {
+		speed    float64
+		interval time.Duration
+		want     time.Duration
+	}{
+		{1, time.Minute, time.Minute},
+		{60, time.Minute, time.Second},
+		{3600, time.Hour, time.Second},
+		{3600, time.Second, minSimulatedInterval},
+		{0, time.Minute, time.Minute},
+		{-5, time.Minute, time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := NewSimulated(tripStart, tt.speed).scale(tt.interval); got != tt.want {
+			t.Errorf("speed %v: scale(%v) = %v, want %v", tt.speed, tt.interval, got, tt.want)
+		}
+	}
+}
+
+func TestSimulatedNow(t *testing.T) {
+	sim := NewSimulated(tripStart, 3600)
+
+	time.Sleep(10 * time.Millisecond)
+	elapsed := sim.Now().Sub(tripStart)
+	if elapsed < 36*time.Second || elapsed > time.Hour {
+		t.Errorf("10ms of real time at 3600x gave %v of trip time", elapsed)
+	}
+
+	target := tripStart.Add(48 * time.Hour)
+	sim.Set(target)
+	if now := sim.Now(); now.Before(target) || now.Sub(target) > time.Hour {
+		t.Errorf("after Set(%v) now = %v", target, now)
+	}
+	if sim.Speed() != 3600 {
+		t.Errorf("speed changed to %v", sim.Speed())
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		start     time.Time
+		speed     float64
+		simulated bool
+	}{
+		{"системные", time.Time{}, 1, false},
+		{"скорость не задана", time.Time{}, 0, false},
+		{"с момента поездки", tripStart, 1, true},
+		{"ускоренные", time.Time{}, 60, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, simulated := New(tt.start, tt.speed).(*Simulated)
+			if simulated != tt.simulated {
+				t.Errorf("simulated = %v, want %v", simulated, tt.simulated)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake часы для тестов: время стоит, пока его не сдвинут Advance или Set.
+// Тикеры и таймеры срабатывают при сдвиге, если их срок наступил
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+// fakeWaiter таймер (period = 0) или тикер фальшивых часов
+type fakeWaiter struct {
+	deadline time.Time
+	period   time.Duration
+	ch       chan time.Time
+}
+
+// NewFake создаёт часы, показывающие start
+func NewFake(start time.Time) *Fake {
+	return &Fake{now: start}
+}
+
+// Now текущий момент
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance сдвигает часы на d и срабатывает наступившие таймеры и тикеры.
+// Тикер, пропустивший несколько периодов, срабатывает один раз (как time.Ticker)
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+
+	active := f.waiters[:0]
+	for _, w := range f.waiters {
+		if w.deadline.After(f.now) {
+			active = append(active, w)
+			continue
+		}
+
+		select {
+		case w.ch <- f.now:
+		default:
+		}
+
+		if w.period > 0 {
+			for !w.deadline.After(f.now) {
+				w.deadline = w.deadline.Add(w.period)
+			}
+			active = append(active, w)
+		}
+	}
+	f.waiters = active
+}
+
+// Set переводит часы на момент t; назад часы не идут
+func (f *Fake) Set(t time.Time) {
+	if d := t.Sub(f.Now()); d > 0 {
+		f.Advance(d)
+	}
+}
+
+// NewTicker тикер с периодом d (d <= 0 вызывает панику, как time.NewTicker)
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	return &fakeTicker{fake: f, waiter: f.add(d, d)}
+}
+
+// After срабатывает, когда часы сдвинут на d
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		ch := make(chan time.Time, 1)
+		ch <- f.Now()
+		return ch
+	}
+	return f.add(d, 0).ch
+}
+
+// Sleep блокируется, пока часы не сдвинут на d
+func (f *Fake) Sleep(d time.Duration) {
+	<-f.After(d)
+}
+
+func (f *Fake) add(d, period time.Duration) *fakeWaiter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &fakeWaiter{deadline: f.now.Add(d), period: period, ch: make(chan time.Time, 1)}
+	f.waiters = append(f.waiters, w)
+	return w
+}
+
+func (f *Fake) remove(target *fakeWaiter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, w := range f.waiters {
+		if w == target {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+type fakeTicker struct {
+	fake   *Fake
+	waiter *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time { return t.waiter.ch }
+func (t *fakeTicker) Stop()               { t.fake.remove(t.waiter) }
@@ -0,0 +1,72 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// minSimulatedInterval нижняя граница реального интервала тикеров симуляции
+const minSimulatedInterval = time.Millisecond
+
+// Simulated ускоренные часы: стартуют с заданного момента поездки
+// и идут в speed раз быстрее реальных. Тикеры и ожидания сжимаются так же
+type Simulated struct {
+	mu     sync.RWMutex
+	start  time.Time // Момент поездки при создании часов
+	origin time.Time // Реальное время создания часов
+	speed  float64
+}
+
+// NewSimulated создаёт часы, показывающие start и идущие со скоростью speed (<= 0 - как 1)
+func NewSimulated(start time.Time, speed float64) *Simulated {
+	if speed <= 0 {
+		speed = 1
+	}
+	return &Simulated{start: start, origin: time.Now(), speed: speed}
+}
+
+// Now текущий момент симуляции
+func (s *Simulated) Now() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	elapsed := time.Duration(float64(time.Since(s.origin)) * s.speed)
+	return s.start.Add(elapsed)
+}
+
+// Set переводит симуляцию на момент t; дальше часы идут от него с той же скоростью
+func (s *Simulated) Set(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.start, s.origin = t, time.Now()
+}
+
+// Speed во сколько раз симуляция быстрее реального времени
+func (s *Simulated) Speed() float64 {
+	return s.speed
+}
+
+// NewTicker тикер с периодом d по часам симуляции
+func (s *Simulated) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(s.scale(d))}
+}
+
+// After срабатывает через d по часам симуляции
+func (s *Simulated) After(d time.Duration) <-chan time.Time {
+	return time.After(s.scale(d))
+}
+
+// Sleep ждёт d по часам симуляции
+func (s *Simulated) Sleep(d time.Duration) {
+	time.Sleep(s.scale(d))
+}
+
+// scale переводит интервал симуляции в реальный
+func (s *Simulated) scale(d time.Duration) time.Duration {
+	interval := time.Duration(float64(d) / s.speed)
+	if interval < minSimulatedInterval {
+		return minSimulatedInterval
+	}
+	return interval
+}
@@ -10,11 +10,50 @@ type Config struct {
 	MaxConcurrentRequests int           `env:"MAX_CONCURRENT_REQUESTS" envDefault:"10"`
 	RateLimitPerSecond    int           `env:"RATE_LIMIT_PER_SECOND" envDefault:"100"`
 	CacheTTL              time.Duration `env:"CACHE_TTL" envDefault:"5m"`
+	CacheMaxEntries       int           `env:"CACHE_MAX_ENTRIES" envDefault:"1000"`
+	CacheEvictionPolicy   string        `env:"CACHE_EVICTION_POLICY" envDefault:"lru"`
+	CacheShards           int           `env:"CACHE_SHARDS" envDefault:"16"`
+	CacheSnapshotPath     string        `env:"CACHE_SNAPSHOT_PATH"`
+	CacheSnapshotInterval time.Duration `env:"CACHE_SNAPSHOT_INTERVAL" envDefault:"5m"`
 	NumWorkers            int           `env:"NUM_WORKERS" envDefault:"5"`
+	LoadBalancerStrategy  string        `env:"LB_STRATEGY" envDefault:"round-robin"`
+	WorkerWeights         []int         `env:"WORKER_WEIGHTS" envSeparator:","`
+	QueueSize             int           `env:"QUEUE_SIZE" envDefault:"100"`
+	QueueTimeout          time.Duration `env:"QUEUE_TIMEOUT" envDefault:"0s"`
 	MaxRetries            int           `env:"MAX_RETRIES" envDefault:"3"`
 	JSONDataPath          string        `env:"JSON_DATA_PATH" envDefault:"reyna_route.json"`
+	JournalDir            string        `env:"JOURNAL_DIR"`
+	JournalEnabled        bool          `env:"JOURNAL_ENABLED" envDefault:"true"`
+	TripID                string        `env:"TRIP_ID"`
+	StoragePath           string        `env:"STORAGE_PATH"`
+	RouteID               string        `env:"ROUTE_ID" envDefault:"moscow-khabarovsk"`
+	ClockStart            time.Time     `env:"CLOCK_START"`
+	ClockSpeed            float64       `env:"CLOCK_SPEED" envDefault:"1"`
+	Language              string        `env:"DEFAULT_LANG" envDefault:"ru"`
 	DebugMode             bool          `env:"DEBUG_MODE" envDefault:"false"`
+	TrackerReport         string        `env:"TRACKER_REPORT" envDefault:"stdout"`
 	ServerPort            string        `env:"SERVER_PORT" envDefault:"8080"`
+	GRPCPort              string        `env:"GRPC_PORT" envDefault:"9090"`
+	BotToken              string        `env:"BOT_TOKEN"`
+	BotAPIURL             string        `env:"BOT_API_URL" envDefault:"https://api.telegram.org"`
+	BotChatIDs            []int64       `env:"BOT_CHAT_IDS" envSeparator:","`
+	BotPollTimeout        time.Duration `env:"BOT_POLL_TIMEOUT" envDefault:"30s"`
+	BotPushEvents         []string      `env:"BOT_PUSH_EVENTS" envSeparator:"," envDefault:"arrival,timezone,date"`
+	BotPushMajorOnly      bool          `env:"BOT_PUSH_MAJOR_ONLY" envDefault:"true"`
+	WebhookStorePath      string        `env:"WEBHOOK_STORE_PATH"`
+	WebhookWorkers        int           `env:"WEBHOOK_WORKERS" envDefault:"4"`
+	WebhookQueueSize      int           `env:"WEBHOOK_QUEUE_SIZE" envDefault:"256"`
+	WebhookMaxAttempts    int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"6"`
+	WebhookBackoff        time.Duration `env:"WEBHOOK_BACKOFF" envDefault:"1s"`
+	WebhookMaxBackoff     time.Duration `env:"WEBHOOK_MAX_BACKOFF" envDefault:"5m"`
+	WebhookTimeout        time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"10s"`
+	WebhookAllowPrivate   bool          `env:"WEBHOOK_ALLOW_PRIVATE" envDefault:"false"`
+	AuthEnabled           bool          `env:"AUTH_ENABLED" envDefault:"true"`
+	AuthAllowInsecure     bool          `env:"AUTH_ALLOW_INSECURE" envDefault:"false"`
+	AuthKeysPath          string        `env:"AUTH_KEYS_PATH" envDefault:"api_keys.json"`
+	AuthRatePerMinute     int           `env:"AUTH_RATE_PER_MINUTE" envDefault:"120"`
+	AuthBurst             int           `env:"AUTH_BURST" envDefault:"20"`
+	AuthDailyQuota        int           `env:"AUTH_DAILY_QUOTA" envDefault:"10000"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -0,0 +1,143 @@
+package dashboard
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+var msk = time.FixedZone("MSK", 3*3600)
+
+func TestParseKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Key
+	}{
+		{"выход", "q", []Key{KeyQuit}},
+		{"Ctrl+C", "\x03", []Key{KeyQuit}},
+		{"одиночный Esc", "\x1b", []Key{KeyQuit}},
+		{"стрелки xterm", "\x1b[D\x1b[C\x1b[A\x1b[B", []Key{KeyLeft, KeyRight, KeyUp, KeyDown}},
+		{"стрелки VT100", "\x1bOD\x1bOA", []Key{KeyLeft, KeyUp}},
+		{"PgUp и PgDn", "\x1b[5~\x1b[6~", []Key{KeyPageUp, KeyPageDown}},
+		{"клавиши vi", "hjkl", []Key{KeyLeft, KeyDown, KeyUp, KeyRight}},
+		{"станции, пауза, сейчас", "nP r0", []Key{KeyNext, KeyPrev, KeyPause, KeyLive, KeyLive}},
+		{"незнакомая последовательность", "n\x1b[Zq", []Key{KeyNext}},
+		{"незнакомые клавиши", "xyz", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseKeys([]byte(tt.input)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseKeys(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestViewerKeys(t *testing.T) {
+	start := time.Date(2025, 10, 7, 1, 0, 0, 0, msk)
+	milestones := []models.Milestone{
+		{At: start.Add(30 * time.Minute), Kind: models.MilestoneArrival},
+		{At: start.Add(40 * time.Minute), Kind: models.MilestoneDeparture},
+		{At: start.Add(2 * time.Hour), Kind: models.MilestoneTimezone}, // Не остановка
+	}
+
+	tests := []struct {
+		name   string
+		keys   []Key
+		offset time.Duration
+	}{
+		{"вправо", []Key{KeyRight}, 10 * time.Minute},
+		{"влево дважды", []Key{KeyLeft, KeyLeft}, -20 * time.Minute},
+		{"час вперёд и день назад", []Key{KeyUp, KeyPageDown}, -23 * time.Hour},
+		{"следующая остановка", []Key{KeyNext}, 30 * time.Minute},
+		{"остановки кончились", []Key{KeyNext, KeyNext, KeyNext}, 40 * time.Minute},
+		{"предыдущей остановки нет", []Key{KeyPrev}, 0},
+		{"назад к прибытию", []Key{KeyPageUp, KeyPrev}, 40 * time.Minute},
+		{"снова сейчас", []Key{KeyUp, KeyNext, KeyLive}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viewer := NewViewer(clock.NewFake(start), milestones)
+			for _, key := range tt.keys {
+				if viewer.Handle(key) {
+					t.Fatalf("key %v asked to quit", key)
+				}
+			}
+			if offset, paused := viewer.State(); offset != tt.offset || paused {
+				t.Errorf("offset %v paused %v, want %v", offset, paused, tt.offset)
+			}
+			if !viewer.Now().Equal(start.Add(tt.offset)) {
+				t.Errorf("now = %v, want %v", viewer.Now(), start.Add(tt.offset))
+			}
+		})
+	}
+
+	if !NewViewer(clock.NewFake(start), nil).Handle(KeyQuit) {
+		t.Error("KeyQuit must end the dashboard")
+	}
+}
+
+func TestViewerPause(t *testing.T) {
+	start := time.Date(2025, 10, 7, 1, 0, 0, 0, msk)
+	fake := clock.NewFake(start)
+	viewer := NewViewer(fake, nil)
+
+	viewer.Handle(KeyPause)
+	fake.Advance(5 * time.Minute)
+	if !viewer.Now().Equal(start) {
+		t.Errorf("paused viewer moved to %v", viewer.Now())
+	}
+
+	// Перемотка на паузе двигает замороженный момент
+	viewer.Handle(KeyRight)
+	if !viewer.Now().Equal(start.Add(10 * time.Minute)) {
+		t.Errorf("paused viewer shifted to %v", viewer.Now())
+	}
+
+	// После паузы время идёт дальше от показанного момента
+	viewer.Handle(KeyPause)
+	fake.Advance(time.Minute)
+	if _, paused := viewer.State(); paused || !viewer.Now().Equal(start.Add(11*time.Minute)) {
+		t.Errorf("resumed viewer at %v, paused %v", viewer.Now(), paused)
+	}
+}
+
+func TestRenderFrame(t *testing.T) {
+	_, handler := trackertest.New(t, time.Date(2025, 10, 7, 0, 0, 0, 0, msk))
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want []string
+	}{
+		{"до отправления", time.Date(2025, 10, 6, 21, 0, 0, 0, msk), []string{"Поезд ещё не отправился", "0 из ", "День поездки: 1"}},
+		{"в пути", time.Date(2025, 10, 7, 0, 10, 0, 0, msk), []string{"В пути: Москва → Владимир Пасс", "До станции Владимир Пасс: 01:00:00", "Москва: 00:10:00 07.10.2025"}},
+		{"на стоянке", time.Date(2025, 10, 7, 1, 20, 0, 0, msk), []string{"Стоянка: Владимир Пасс", "До отправления: 00:16:00"}},
+		{"прибыл", time.Date(2025, 10, 14, 4, 0, 0, 0, msk), []string{"Поезд прибыл на станцию Хабаровск 1", "(100.0%)", "   —"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frame := Capture(handler, NewViewer(clock.NewFake(tt.at), nil), i18n.Russian)
+			lines := Render(frame, 80, i18n.Russian)
+			output := strings.Join(lines, "\n")
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("frame has no %q:\n%s", want, output)
+				}
+			}
+			if !strings.Contains(output, "Текущее время") {
+				t.Errorf("live frame must say so:\n%s", output)
+			}
+		})
+	}
+}
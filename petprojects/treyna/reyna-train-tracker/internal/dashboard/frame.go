@@ -0,0 +1,181 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/utils"
+)
+
+// upcomingLimit сколько крупных станций впереди показывает панель
+const upcomingLimit = 5
+
+// Оформление ANSI
+const (
+	bold  = "\x1b[1m"
+	dim   = "\x1b[2m"
+	green = "\x1b[32m"
+	reset = "\x1b[0m"
+)
+
+// Frame кадр панели: всё, что показывается в один момент
+type Frame struct {
+	At         time.Time
+	Route      string
+	Position   *models.CurrentPosition
+	Status     models.TrainStatus
+	Journey    models.JourneyInfo
+	DistanceKm float64
+	TotalKm    int
+	First      models.StationInfo
+	Last       models.StationInfo
+	Upcoming   []map[string]interface{} // Ответ вопроса 10
+	Offset     time.Duration            // Сдвиг относительно часов трекера
+	Paused     bool
+}
+
+// Capture собирает кадр на момент viewer.Now()
+func Capture(h *api.QuestionHandler, v *Viewer, lang i18n.Lang) Frame {
+	t := h.Tracker
+	f := Frame{
+		At:      v.Now(),
+		Route:   i18n.StationName(lang, t.RouteData.Name),
+		TotalKm: t.RouteData.TotalDistance,
+	}
+	f.Offset, f.Paused = v.State()
+	if len(t.Stations) == 0 {
+		return f
+	}
+	f.First, f.Last = t.Stations[0], t.Stations[len(t.Stations)-1]
+
+	f.Journey = t.GetJourneyInfo(f.At)
+	// До отправления и после прибытия трекер держит поезд на крайней станции,
+	// а панель показывает для этих моментов свои экраны
+	switch {
+	case f.At.Before(f.First.DepartureTime):
+		return f
+	case !f.At.Before(f.Last.ArrivalTime):
+		f.DistanceKm = float64(f.Last.DistanceFromStart)
+		return f
+	}
+
+	f.Position = t.GetCurrentPosition(f.At)
+	if f.Position != nil {
+		f.DistanceKm = f.Position.DistanceFromStart
+		f.Status = t.GetTrainStatus(f.At, f.Position)
+		if upcoming, ok := h.Question10_UpcomingStations(f.Position, lang)["upcoming_stations"].([]map[string]interface{}); ok {
+			f.Upcoming = upcoming
+		}
+	}
+	return f
+}
+
+// Render кадр в виде строк; width - ширина терминала в символах
+func Render(f Frame, width int, lang i18n.Lang) []string {
+	width = max(width, 40)
+	tz := f.Journey.Timezone
+	if f.Position != nil && f.Position.Timezone != "" {
+		tz = f.Position.Timezone
+	}
+
+	lines := []string{
+		bold + i18n.T(lang, "tui.title", f.Route) + reset,
+		"",
+		progressBar(f.DistanceKm, f.TotalKm, width),
+		i18n.T(lang, "tui.progress", f.DistanceKm, f.TotalKm, percent(f.DistanceKm, f.TotalKm)),
+		"",
+	}
+	lines = append(lines, positionLines(f, lang)...)
+
+	lines = append(lines,
+		"",
+		i18n.T(lang, "tui.local", inZone(f.At, tz).Format("15:04:05 02.01.2006"), tz),
+		i18n.T(lang, "tui.moscow", inZone(f.At, "Europe/Moscow").Format("15:04:05 02.01.2006")),
+		i18n.T(lang, "tui.day", max(f.Journey.CalendarDay, 1)),
+		"",
+		i18n.T(lang, "tui.upcoming"),
+	)
+	if len(f.Upcoming) == 0 {
+		lines = append(lines, i18n.T(lang, "tui.none"))
+	}
+	for i, station := range f.Upcoming {
+		if i == upcomingLimit {
+			break
+		}
+		lines = append(lines, i18n.T(lang, "tui.station_row",
+			station["name"], station["arrival_time"], station["distance"], station["stand_duration"]))
+	}
+
+	mode := green + i18n.T(lang, "tui.live") + reset
+	switch {
+	case f.Paused:
+		mode = bold + i18n.T(lang, "tui.paused") + reset
+	case f.Offset.Abs() >= time.Minute:
+		mode = bold + i18n.T(lang, "tui.shifted", i18n.FormatOffset(lang, f.Offset.Round(time.Minute))) + reset
+	}
+	lines = append(lines, "", mode, dim+truncate(i18n.T(lang, "tui.keys"), width)+reset)
+	return lines
+}
+
+// positionLines где поезд: до отправления, на станции, в пути или уже прибыл
+func positionLines(f Frame, lang i18n.Lang) []string {
+	pos := f.Position
+	switch {
+	case pos == nil && f.At.Before(f.First.DepartureTime):
+		return []string{i18n.T(lang, "tui.not_started", i18n.FormatDuration(lang, f.First.DepartureTime.Sub(f.At)))}
+	case pos == nil:
+		return []string{i18n.T(lang, "tui.finished", i18n.StationName(lang, f.Last.Name))}
+	case pos.IsAtStation && pos.CurrentStation != nil:
+		return []string{
+			bold + i18n.T(lang, "tui.at_station", i18n.StationName(lang, pos.CurrentStation.Name)) + reset,
+			i18n.T(lang, "tui.stand_left", countdown(f.Status.RemainingStand)),
+		}
+	case pos.PreviousStation != nil && pos.NextStation != nil:
+		return []string{
+			bold + i18n.T(lang, "tui.moving",
+				i18n.StationName(lang, pos.PreviousStation.Name), i18n.StationName(lang, pos.NextStation.Name)) + reset,
+			i18n.T(lang, "tui.next", i18n.StationName(lang, pos.NextStation.Name), countdown(f.Status.TimeToNext)),
+		}
+	}
+	return nil
+}
+
+// progressBar полоса пройденного пути по всей ширине терминала
+func progressBar(distance float64, total, width int) string {
+	size := width - 2
+	filled := int(percent(distance, total) / 100 * float64(size))
+	filled = min(max(filled, 0), size)
+	return "[" + green + strings.Repeat("█", filled) + reset + strings.Repeat("░", size-filled) + "]"
+}
+
+func percent(distance float64, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return min(max(distance/float64(total)*100, 0), 100)
+}
+
+// countdown обратный отсчёт с секундами: панель обновляется каждую секунду
+func countdown(d time.Duration) string {
+	d = max(d, 0).Truncate(time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
+
+func inZone(t time.Time, tz string) time.Time {
+	if local, err := utils.ConvertToTimezone(t, tz); err == nil {
+		return local
+	}
+	return t
+}
+
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width-1]) + "…"
+}
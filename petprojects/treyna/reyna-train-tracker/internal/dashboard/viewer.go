@@ -0,0 +1,235 @@
+package dashboard
+
+import (
+	"sync"
+	"time"
+
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/models"
+)
+
+// Шаги перемотки по клавишам
+const (
+	stepSmall = 10 * time.Minute
+	stepHour  = time.Hour
+	stepDay   = 24 * time.Hour
+)
+
+// Viewer момент, который показывает панель: часы трекера со сдвигом,
+// который меняют клавиши, или замороженный момент на паузе
+type Viewer struct {
+	mu       sync.Mutex
+	clock    clock.Clock
+	offset   time.Duration
+	paused   bool
+	pausedAt time.Time
+	stops    []time.Time // Прибытия и отправления по порядку - для n/p
+}
+
+// NewViewer создаёт просмотр по часам clk; milestones задают точки перехода n/p
+func NewViewer(clk clock.Clock, milestones []models.Milestone) *Viewer {
+	v := &Viewer{clock: clk}
+	for _, m := range milestones {
+		if m.Kind == models.MilestoneArrival || m.Kind == models.MilestoneDeparture {
+			v.stops = append(v.stops, m.At)
+		}
+	}
+	return v
+}
+
+// Now показываемый момент
+func (v *Viewer) Now() time.Time {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.now()
+}
+
+func (v *Viewer) now() time.Time {
+	if v.paused {
+		return v.pausedAt
+	}
+	return v.clock.Now().Add(v.offset)
+}
+
+// State сдвиг относительно часов и признак паузы
+func (v *Viewer) State() (time.Duration, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.offset, v.paused
+}
+
+// Shift перематывает на d вперёд (или назад при d < 0)
+func (v *Viewer) Shift(d time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.jump(v.now().Add(d))
+}
+
+// TogglePause замораживает или отпускает показываемый момент
+func (v *Viewer) TogglePause() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.paused {
+		// Продолжаем с того же момента: пауза превращается в сдвиг
+		v.offset = v.pausedAt.Sub(v.clock.Now())
+		v.paused = false
+		return
+	}
+	v.pausedAt = v.now()
+	v.paused = true
+}
+
+// Live возвращает к текущему времени часов без паузы
+func (v *Viewer) Live() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.offset, v.paused = 0, false
+}
+
+// NextStop переходит к ближайшему следующему прибытию или отправлению
+func (v *Viewer) NextStop() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	for _, at := range v.stops {
+		if at.After(now) {
+			v.jump(at)
+			return
+		}
+	}
+}
+
+// PrevStop переходит к предыдущему прибытию или отправлению
+func (v *Viewer) PrevStop() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	for i := len(v.stops) - 1; i >= 0; i-- {
+		if v.stops[i].Before(now) {
+			v.jump(v.stops[i])
+			return
+		}
+	}
+}
+
+// jump показывает момент at (вызывается под блокировкой)
+func (v *Viewer) jump(at time.Time) {
+	if v.paused {
+		v.pausedAt = at
+		return
+	}
+	v.offset = at.Sub(v.clock.Now())
+}
+
+// Key клавиша, распознанная во вводе терминала
+type Key int
+
+const (
+	KeyUnknown Key = iota
+	KeyQuit
+	KeyLeft
+	KeyRight
+	KeyUp
+	KeyDown
+	KeyPageUp
+	KeyPageDown
+	KeyNext
+	KeyPrev
+	KeyPause
+	KeyLive
+)
+
+// escapeSequences последовательности стрелок и PgUp/PgDn (xterm, VT100)
+var escapeSequences = map[string]Key{
+	"\x1b[D":  KeyLeft,
+	"\x1b[C":  KeyRight,
+	"\x1b[A":  KeyUp,
+	"\x1b[B":  KeyDown,
+	"\x1bOD":  KeyLeft,
+	"\x1bOC":  KeyRight,
+	"\x1bOA":  KeyUp,
+	"\x1bOB":  KeyDown,
+	"\x1b[5~": KeyPageUp,
+	"\x1b[6~": KeyPageDown,
+}
+
+// ParseKeys разбирает прочитанный из терминала ввод в сыром режиме
+func ParseKeys(input []byte) []Key {
+	var keys []Key
+	for i := 0; i < len(input); {
+		if input[i] == 0x1b {
+			matched := false
+			for seq, key := range escapeSequences {
+				if len(input)-i >= len(seq) && string(input[i:i+len(seq)]) == seq {
+					keys = append(keys, key)
+					i += len(seq)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				// Одиночный Esc - выход, незнакомую последовательность пропускаем целиком
+				if i+1 == len(input) {
+					keys = append(keys, KeyQuit)
+				}
+				i = len(input)
+			}
+			continue
+		}
+
+		switch input[i] {
+		case 'q', 'Q', 0x03: // 0x03 - Ctrl+C в сыром режиме
+			keys = append(keys, KeyQuit)
+		case 'h':
+			keys = append(keys, KeyLeft)
+		case 'l':
+			keys = append(keys, KeyRight)
+		case 'k':
+			keys = append(keys, KeyUp)
+		case 'j':
+			keys = append(keys, KeyDown)
+		case 'n', 'N':
+			keys = append(keys, KeyNext)
+		case 'p', 'P':
+			keys = append(keys, KeyPrev)
+		case ' ':
+			keys = append(keys, KeyPause)
+		case 'r', 'R', '0':
+			keys = append(keys, KeyLive)
+		}
+		i++
+	}
+	return keys
+}
+
+// Handle применяет клавишу; true - пора выходить
+func (v *Viewer) Handle(key Key) bool {
+	switch key {
+	case KeyQuit:
+		return true
+	case KeyLeft:
+		v.Shift(-stepSmall)
+	case KeyRight:
+		v.Shift(stepSmall)
+	case KeyUp:
+		v.Shift(stepHour)
+	case KeyDown:
+		v.Shift(-stepHour)
+	case KeyPageUp:
+		v.Shift(stepDay)
+	case KeyPageDown:
+		v.Shift(-stepDay)
+	case KeyNext:
+		v.NextStop()
+	case KeyPrev:
+		v.PrevStop()
+	case KeyPause:
+		v.TogglePause()
+	case KeyLive:
+		v.Live()
+	}
+	return false
+}
@@ -0,0 +1,296 @@
+// Package gql GraphQL-схема поверх трекера и обработчика вопросов:
+// маршрут со станциями, позиция поезда и ответы на вопросы одним запросом
+package gql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/utils"
+)
+
+// Пояса, в которых можно запросить время станции
+const (
+	zoneMoscow = "moscow"
+	zoneLocal  = "local"
+	zoneUTC    = "utc"
+)
+
+// ErrShuttingDown обработчик вопросов остановлен
+var ErrShuttingDown = errors.New("server is shutting down")
+
+// Request GraphQL-запрос в формате POST-тела
+type Request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// Executor выполняет запросы по схеме, собранной один раз при создании
+type Executor struct {
+	Tracker *tracker.TrainTracker
+	Handler *api.QuestionHandler
+
+	schema graphql.Schema
+}
+
+// langKey ключ языка запроса в контексте резолверов
+type langKey struct{}
+
+// NewExecutor собирает схему. Схема статическая, поэтому ошибка сборки -
+// ошибка программы, и конструктор паникует (как regexp.MustCompile)
+func NewExecutor(t *tracker.TrainTracker, h *api.QuestionHandler) *Executor {
+	e := &Executor{Tracker: t, Handler: h}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: e.queryType()})
+	if err != nil {
+		panic(fmt.Sprintf("graphql schema: %v", err))
+	}
+	e.schema = schema
+	return e
+}
+
+// Execute выполняет запрос; lang - язык названий станций и ответов
+func (e *Executor) Execute(ctx context.Context, req Request, lang i18n.Lang) *graphql.Result {
+	return graphql.Do(graphql.Params{
+		Schema:         e.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        context.WithValue(ctx, langKey{}, lang),
+	})
+}
+
+// requestLang язык из контекста резолвера; без него - язык обработчика
+func (e *Executor) requestLang(ctx context.Context) i18n.Lang {
+	if lang, ok := ctx.Value(langKey{}).(i18n.Lang); ok {
+		return lang
+	}
+	return e.Handler.Language
+}
+
+// requestTime аргумент at; без него - время по часам трекера
+func (e *Executor) requestTime(p graphql.ResolveParams) time.Time {
+	if at, ok := p.Args["at"].(time.Time); ok {
+		return at
+	}
+	return e.Tracker.Now()
+}
+
+func (e *Executor) queryType() *graphql.Object {
+	station := e.stationType()
+	atArg := &graphql.ArgumentConfig{
+		Type:        graphql.DateTime,
+		Description: "Момент (RFC3339); без него - сейчас по часам трекера",
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"route": &graphql.Field{
+				Type: graphql.NewNonNull(e.routeType(station)),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return e.Tracker.RouteData, nil
+				},
+			},
+			"position": &graphql.Field{
+				Type:        positionType(station),
+				Description: "Позиция поезда; null, если её нет",
+				Args:        graphql.FieldConfigArgument{"at": atArg},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					at := e.requestTime(p)
+					pos := e.Tracker.GetCurrentPosition(at)
+					if pos == nil {
+						return nil, nil
+					}
+					return position{at: at, pos: pos}, nil
+				},
+			},
+			"answers": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(answerType))),
+				Description: "Ответы на вопросы numbers (без списка - на все), по номеру вопроса",
+				Args: graphql.FieldConfigArgument{
+					"at":      atArg,
+					"numbers": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.Int))},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var numbers []int
+					if raw, ok := p.Args["numbers"].([]interface{}); ok {
+						for _, n := range raw {
+							numbers = append(numbers, n.(int))
+						}
+					}
+					results := e.Handler.ProcessQuestionsIn(e.requestTime(p), e.requestLang(p.Context), numbers)
+					if results == nil {
+						return nil, ErrShuttingDown
+					}
+					return results, nil
+				},
+			},
+		},
+	})
+}
+
+func (e *Executor) routeType(station *graphql.Object) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Route",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return i18n.StationName(e.requestLang(p.Context), p.Source.(models.RouteData).Name), nil
+				},
+			},
+			"totalDistanceKm": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(models.RouteData).TotalDistance, nil
+				},
+			},
+			"start": &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(models.RouteData).StartTime, nil
+				},
+			},
+			"stations": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(station))),
+				Args: graphql.FieldConfigArgument{
+					"majorOnly": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					majorOnly, _ := p.Args["majorOnly"].(bool)
+					stations := make([]*models.StationInfo, 0, len(e.Tracker.Stations))
+					for i := range e.Tracker.Stations {
+						if !majorOnly || e.Tracker.Stations[i].IsMajor {
+							stations = append(stations, &e.Tracker.Stations[i])
+						}
+					}
+					return stations, nil
+				},
+			},
+		},
+	})
+}
+
+var timeZoneEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "TimeZone",
+	Values: graphql.EnumValueConfigMap{
+		"MOSCOW": &graphql.EnumValueConfig{Value: zoneMoscow, Description: "Московское время (как в расписании)"},
+		"LOCAL":  &graphql.EnumValueConfig{Value: zoneLocal, Description: "Местное время станции"},
+		"UTC":    &graphql.EnumValueConfig{Value: zoneUTC},
+	},
+})
+
+func (e *Executor) stationType() *graphql.Object {
+	tzArg := graphql.FieldConfigArgument{
+		"tz": &graphql.ArgumentConfig{Type: timeZoneEnum, DefaultValue: zoneMoscow},
+	}
+	stations := e.Tracker.Stations
+	isFirst := func(s *models.StationInfo) bool { return len(stations) > 0 && s.ID == stations[0].ID }
+	isLast := func(s *models.StationInfo) bool { return len(stations) > 0 && s.ID == stations[len(stations)-1].ID }
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Station",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*models.StationInfo).ID, nil
+				},
+			},
+			"name": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.String),
+				Description: "Название на языке запроса",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return i18n.StationName(e.requestLang(p.Context), p.Source.(*models.StationInfo).Name), nil
+				},
+			},
+			"scheduleName": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.String),
+				Description: "Название из расписания",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*models.StationInfo).Name, nil
+				},
+			},
+			"timezone": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*models.StationInfo).Timezone, nil
+				},
+			},
+			"arrival": &graphql.Field{
+				Type:        graphql.DateTime,
+				Description: "Прибытие; null на первой станции",
+				Args:        tzArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					s := p.Source.(*models.StationInfo)
+					if isFirst(s) {
+						return nil, nil
+					}
+					return inZone(s.ArrivalTime, s.Timezone, p.Args["tz"])
+				},
+			},
+			"departure": &graphql.Field{
+				Type:        graphql.DateTime,
+				Description: "Отправление; null на последней станции",
+				Args:        tzArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					s := p.Source.(*models.StationInfo)
+					if isLast(s) {
+						return nil, nil
+					}
+					return inZone(s.DepartureTime, s.Timezone, p.Args["tz"])
+				},
+			},
+			"stand": &graphql.Field{
+				Type:        graphql.String,
+				Description: "Стоянка словами на языке запроса; null на конечных",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					s := p.Source.(*models.StationInfo)
+					if isFirst(s) || isLast(s) {
+						return nil, nil
+					}
+					return i18n.FormatDuration(e.requestLang(p.Context), s.StandDuration), nil
+				},
+			},
+			"standMinutes": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return int(p.Source.(*models.StationInfo).StandDuration / time.Minute), nil
+				},
+			},
+			"distanceKm": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*models.StationInfo).DistanceFromStart, nil
+				},
+			},
+			"isMajor": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*models.StationInfo).IsMajor, nil
+				},
+			},
+		},
+	})
+}
+
+// inZone момент t в поясе zone (moscow, local - пояс станции, utc)
+func inZone(t time.Time, stationTZ string, zone interface{}) (interface{}, error) {
+	switch zone {
+	case zoneLocal:
+		return utils.ConvertToTimezone(t, stationTZ)
+	case zoneUTC:
+		return t.UTC(), nil
+	default:
+		return utils.ConvertToTimezone(t, "Europe/Moscow")
+	}
+}
@@ -0,0 +1,77 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+func newTestExecutor(t *testing.T, at time.Time) *Executor {
+	t.Helper()
+
+	trainTracker, handler := trackertest.New(t, at)
+
+	return NewExecutor(trainTracker, handler)
+}
+
+func TestQuery(t *testing.T) {
+	e := newTestExecutor(t, time.Date(2025, 10, 12, 20, 30, 0, 0, time.FixedZone("MSK", 3*3600)))
+
+	result := e.Execute(context.Background(), Request{Query: `{
+		route { stations(majorOnly: true) { name arrival(tz: LOCAL) stand isMajor } }
+		position { atStation nextStation { name } localTime }
+		answers(numbers: [5, 1, 99]) { number answer error }
+	}`}, i18n.English)
+	if result.HasErrors() {
+		t.Fatalf("query errors: %v", result.Errors)
+	}
+
+	var data struct {
+		Route struct {
+			Stations []struct {
+				Name    string  `json:"name"`
+				Arrival *string `json:"arrival"`
+				Stand   *string `json:"stand"`
+				IsMajor bool    `json:"isMajor"`
+			} `json:"stations"`
+		} `json:"route"`
+		Position *struct {
+			NextStation *struct{ Name string } `json:"nextStation"`
+			LocalTime   string                 `json:"localTime"`
+		} `json:"position"`
+		Answers []struct {
+			Number int     `json:"number"`
+			Error  *string `json:"error"`
+		} `json:"answers"`
+	}
+	raw, _ := json.Marshal(result.Data)
+	if err := json.Unmarshal(raw, &data); err != nil {
+		t.Fatal(err)
+	}
+
+	stations := data.Route.Stations
+	if len(stations) < 2 || stations[0].Name != "Moskva" || stations[0].Arrival != nil || stations[0].Stand != nil {
+		t.Errorf("first station must be departure-only and localized: %+v", stations)
+	}
+	if last := stations[len(stations)-1]; last.Arrival == nil || (*last.Arrival)[len(*last.Arrival)-6:] != "+10:00" {
+		t.Errorf("last arrival must be in local time: %+v", last)
+	}
+	if data.Position == nil || data.Position.NextStation == nil || data.Position.LocalTime == "" {
+		t.Errorf("unexpected position: %+v", data.Position)
+	}
+
+	numbers := []int{}
+	for _, a := range data.Answers {
+		numbers = append(numbers, a.Number)
+	}
+	if len(numbers) != 3 || numbers[0] != 1 || numbers[1] != 5 || numbers[2] != 99 {
+		t.Fatalf("answers must be ordered by number: %v", numbers)
+	}
+	if data.Answers[0].Error != nil || data.Answers[2].Error == nil {
+		t.Errorf("only the unknown question must fail: %+v", data.Answers)
+	}
+}
@@ -0,0 +1,137 @@
+package gql
+
+import (
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/utils"
+)
+
+// position источник резолверов Position: момент запроса нужен для местного времени
+type position struct {
+	at  time.Time
+	pos *models.CurrentPosition
+}
+
+func positionType(station *graphql.Object) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Position",
+		Fields: graphql.Fields{
+			"at": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.DateTime),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(position).at, nil
+				},
+			},
+			"atStation": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(position).pos.IsAtStation, nil
+				},
+			},
+			"currentStation": &graphql.Field{
+				Type:        station,
+				Description: "Только на станции",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pos := p.Source.(position).pos
+					if !pos.IsAtStation || pos.CurrentStation == nil {
+						return nil, nil
+					}
+					return pos.CurrentStation, nil
+				},
+			},
+			"previousStation": &graphql.Field{
+				Type: station,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return stationOrNil(p.Source.(position).pos.PreviousStation), nil
+				},
+			},
+			"nextStation": &graphql.Field{
+				Type: station,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return stationOrNil(p.Source.(position).pos.NextStation), nil
+				},
+			},
+			"distanceKm": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Float),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(position).pos.DistanceFromStart, nil
+				},
+			},
+			"timezone": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(position).pos.Timezone, nil
+				},
+			},
+			"localTime": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.DateTime),
+				Description: "Местное время пассажира",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					source := p.Source.(position)
+					return utils.ConvertToTimezone(source.at, source.pos.Timezone)
+				},
+			},
+		},
+	})
+}
+
+// stationOrNil nil-указатель в интерфейсе не равен nil, и graphql-go
+// вызвал бы резолверы станции; возвращаем настоящий nil
+func stationOrNil(s *models.StationInfo) interface{} {
+	if s == nil {
+		return nil
+	}
+	return s
+}
+
+// jsonScalar ответ вопроса как есть: у вопросов (в том числе из плагинов) нет общей схемы
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "Произвольное JSON-значение",
+	Serialize:   func(value interface{}) interface{} { return value },
+})
+
+var answerType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Answer",
+	Fields: graphql.Fields{
+		"number": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.QuestionResult).QuestionNumber, nil
+			},
+		},
+		"text": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.QuestionResult).QuestionText, nil
+			},
+		},
+		"answer": &graphql.Field{
+			Type: jsonScalar,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.QuestionResult).Answer, nil
+			},
+		},
+		"error": &graphql.Field{
+			Type:        graphql.String,
+			Description: "Ошибка вопроса: неизвестный номер, перегрузка, нет позиции",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if answer, ok := p.Source.(models.QuestionResult).Answer.(map[string]interface{}); ok {
+					if message, ok := answer["error"].(string); ok {
+						return message, nil
+					}
+				}
+				return nil, nil
+			},
+		},
+		"processedAt": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.DateTime),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.QuestionResult).ProcessedAt, nil
+			},
+		},
+	},
+})
@@ -0,0 +1,63 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"reyna-train-tracker/internal/auth"
+	"reyna-train-tracker/pkg/trackerpb"
+)
+
+// AuthOptions перехватчики, которые требуют ключ с правом position для всех
+// методов TrackerService (как в REST: метаданные authorization или x-api-key).
+// Reflection остаётся открытым: он отдаёт только схему
+func AuthOptions(a *auth.Authenticator) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := authorize(ctx, a, info.FullMethod); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authorize(stream.Context(), a, info.FullMethod); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}),
+	}
+}
+
+// authorize проверяет ключ из метаданных и переводит отказ в код gRPC
+func authorize(ctx context.Context, a *auth.Authenticator, method string) error {
+	if !strings.HasPrefix(method, "/"+trackerpb.TrackerService_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	_, err := a.Authorize(auth.TokenFromHeaders(first("authorization"), first("x-api-key")), auth.ScopePosition)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, auth.ErrUnauthorized):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, auth.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, auth.ErrRateLimited), errors.Is(err, auth.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,257 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/utils"
+	"reyna-train-tracker/pkg/trackerpb"
+)
+
+// upcomingLimit сколько крупных станций впереди возвращает вопрос 10 (как в REST)
+const upcomingLimit = 10
+
+// question общая часть вопросов 1-10: момент, язык, позиция и счётчик вопроса
+func (s *Server) question(ctx context.Context, n int, req *trackerpb.TimeRequest) (time.Time, i18n.Lang, *models.CurrentPosition) {
+	s.Tracker.IncrementQuestionCounter(n)
+	at := s.requestTime(req.GetAt())
+	return at, s.requestLang(ctx, req.GetLang()), s.Tracker.GetCurrentPosition(at)
+}
+
+// LocalTime вопрос 1: местное время пассажира
+func (s *Server) LocalTime(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.LocalTimeAnswer, error) {
+	at, _, pos := s.question(ctx, 1, req)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+	return &trackerpb.LocalTimeAnswer{LocalTime: localRFC3339(at, pos.Timezone), Timezone: pos.Timezone}, nil
+}
+
+// CurrentStation вопрос 2: станция или перегон
+func (s *Server) CurrentStation(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.CurrentStationAnswer, error) {
+	_, lang, pos := s.question(ctx, 2, req)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+
+	answer := &trackerpb.CurrentStationAnswer{DistanceKm: int32(pos.DistanceFromStart)}
+	if pos.IsAtStation && pos.CurrentStation != nil {
+		answer.AtStation = true
+		answer.Station = i18n.StationName(lang, pos.CurrentStation.Name)
+		return answer, nil
+	}
+	answer.Previous = stationName(pos.PreviousStation, lang)
+	answer.Next = stationName(pos.NextStation, lang)
+	return answer, nil
+}
+
+// TrainState вопрос 3: стоит или едет
+func (s *Server) TrainState(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.TrainStateAnswer, error) {
+	at, lang, pos := s.question(ctx, 3, req)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+
+	state := s.Tracker.GetTrainStatus(at, pos)
+	if !state.IsMoving && pos.CurrentStation != nil {
+		return &trackerpb.TrainStateAnswer{
+			Status:         i18n.T(lang, "answer.status_standing"),
+			Station:        i18n.StationName(lang, pos.CurrentStation.Name),
+			StandDuration:  durationpb.New(pos.CurrentStation.StandDuration),
+			RemainingStand: durationpb.New(state.RemainingStand),
+		}, nil
+	}
+	return &trackerpb.TrainStateAnswer{
+		Moving:     true,
+		Status:     i18n.T(lang, "answer.status_moving"),
+		From:       stationName(pos.PreviousStation, lang),
+		To:         stationName(pos.NextStation, lang),
+		TimeToNext: durationpb.New(state.TimeToNext),
+	}, nil
+}
+
+// JourneyDay вопрос 4: день путешествия
+func (s *Server) JourneyDay(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.JourneyDayAnswer, error) {
+	at, lang, _ := s.question(ctx, 4, req)
+	answer := &trackerpb.JourneyDayAnswer{Journey: journeyProto(s.Tracker.GetJourneyInfo(at))}
+	// Описание смены даты берём из ответа REST, чтобы формулировки совпадали
+	if next, ok := s.Handler.Question4_JourneyDay(at, lang)["next_date_change"].(string); ok {
+		answer.NextDateChange = next
+	}
+	return answer, nil
+}
+
+// Distance вопрос 5: расстояние от Москвы
+func (s *Server) Distance(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.DistanceAnswer, error) {
+	_, lang, pos := s.question(ctx, 5, req)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+
+	location := i18n.T(lang, "answer.between_stations")
+	if pos.IsAtStation && pos.CurrentStation != nil {
+		location = i18n.StationName(lang, pos.CurrentStation.Name)
+	}
+	return &trackerpb.DistanceAnswer{DistanceKm: int32(pos.DistanceFromStart), Location: location}, nil
+}
+
+// NextArrival вопрос 6: прогноз прибытия на следующую станцию
+func (s *Server) NextArrival(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.NextArrivalAnswer, error) {
+	at, lang, pos := s.question(ctx, 6, req)
+	if pos == nil || pos.NextStation == nil {
+		return nil, status.Error(codes.NotFound, "next station not found")
+	}
+	prediction, ok := s.Tracker.PredictArrival(pos.NextStation.ID, at)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "next station not found")
+	}
+
+	return &trackerpb.NextArrivalAnswer{
+		Station:       i18n.StationName(lang, pos.NextStation.Name),
+		Scheduled:     timestamppb.New(prediction.Scheduled),
+		Expected:      timestamppb.New(prediction.Expected),
+		Earliest:      timestamppb.New(prediction.Earliest),
+		Latest:        timestamppb.New(prediction.Latest),
+		Delay:         durationpb.New(prediction.Delay),
+		Confidence:    prediction.Confidence,
+		Observations:  int32(prediction.Observations),
+		TimeRemaining: durationpb.New(max(prediction.Expected.Sub(at), 0)),
+	}, nil
+}
+
+// TimeDifference вопрос 7: разница с Москвой и ближайший перевод часов
+func (s *Server) TimeDifference(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.TimeDifferenceAnswer, error) {
+	at, _, pos := s.question(ctx, 7, req)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+
+	diff, err := utils.GetTimezoneDifference("Europe/Moscow", pos.Timezone, at)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	answer := &trackerpb.TimeDifferenceAnswer{
+		MoscowTime: localRFC3339(at, "Europe/Moscow"),
+		LocalTime:  localRFC3339(at, pos.Timezone),
+		Timezone:   pos.Timezone,
+		Difference: durationpb.New(diff),
+	}
+	if changes := s.Tracker.UpcomingTimezoneChanges(at); len(changes) > 0 {
+		next := changes[0]
+		answer.NextClockChange = &trackerpb.ClockChange{
+			Timezone:   next.Border.To,
+			Shift:      durationpb.New(next.Shift),
+			In:         durationpb.New(next.In),
+			DistanceKm: next.DistanceKm,
+		}
+	}
+	return answer, nil
+}
+
+// MessageToHer вопрос 8: пишу из Москвы - когда у неё
+func (s *Server) MessageToHer(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.MessageDeliveryAnswer, error) {
+	at, lang, pos := s.question(ctx, 8, req)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+	return messageDelivery(at, "Europe/Moscow", pos.Timezone, lang), nil
+}
+
+// MessageFromHer вопрос 9: она пишет - когда в Москве
+func (s *Server) MessageFromHer(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.MessageDeliveryAnswer, error) {
+	at, lang, pos := s.question(ctx, 9, req)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+	return messageDelivery(at, pos.Timezone, "Europe/Moscow", lang), nil
+}
+
+// UpcomingStations вопрос 10: крупные станции впереди
+func (s *Server) UpcomingStations(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.UpcomingStationsAnswer, error) {
+	_, lang, pos := s.question(ctx, 10, req)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+
+	stations := s.Tracker.Stations
+	current := 0
+	if pos.IsAtStation && pos.CurrentStation != nil {
+		current = tracker.FindStationIndex(stations, pos.CurrentStation.ID)
+	} else if pos.NextStation != nil {
+		current = tracker.FindStationIndex(stations, pos.NextStation.ID)
+	}
+
+	answer := &trackerpb.UpcomingStationsAnswer{}
+	for i := max(current, 0); i < len(stations) && len(answer.Stations) < upcomingLimit; i++ {
+		if stations[i].IsMajor {
+			answer.Stations = append(answer.Stations, stationProto(&stations[i], lang))
+		}
+	}
+	return answer, nil
+}
+
+// Ask любой вопрос из реестра; ответ - тот же объект, что в REST
+func (s *Server) Ask(ctx context.Context, req *trackerpb.AskRequest) (*trackerpb.AskResponse, error) {
+	question, ok := s.Handler.Questions.Get(int(req.GetQuestion()))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown question %d", req.GetQuestion())
+	}
+	s.Tracker.IncrementQuestionCounter(question.ID())
+
+	at := s.requestTime(req.GetAt())
+	lang := s.requestLang(ctx, req.GetLang())
+	answer, err := toStruct(question.Answer(api.QuestionContext{
+		Tracker:     s.Tracker,
+		CurrentTime: at,
+		Position:    s.Tracker.GetCurrentPosition(at),
+		Lang:        lang,
+	}))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &trackerpb.AskResponse{Question: int32(question.ID()), Text: question.Text(lang), Answer: answer}, nil
+}
+
+func messageDelivery(at time.Time, from, to string, lang i18n.Lang) *trackerpb.MessageDeliveryAnswer {
+	return &trackerpb.MessageDeliveryAnswer{
+		SendTime:         localRFC3339(at, from),
+		ReceiveTime:      localRFC3339(at, to),
+		SenderTimezone:   from,
+		ReceiverTimezone: to,
+		Instant:          true,
+		Note:             i18n.T(lang, "answer.instant_delivery"),
+	}
+}
+
+func stationName(s *models.StationInfo, lang i18n.Lang) string {
+	if s == nil {
+		return ""
+	}
+	return i18n.StationName(lang, s.Name)
+}
+
+// toStruct ответ вопроса в google.protobuf.Struct. Через JSON, потому что в ответах
+// встречаются типы, которых structpb.NewStruct не знает ([]map[string]interface{})
+func toStruct(answer map[string]interface{}) (*structpb.Struct, error) {
+	data, err := json.Marshal(answer)
+	if err != nil {
+		return nil, fmt.Errorf("encode answer: %w", err)
+	}
+	result := &structpb.Struct{}
+	if err := result.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("convert answer: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,211 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/utils"
+	"reyna-train-tracker/pkg/trackerpb"
+)
+
+// Интервалы WatchPosition (по часам трекера)
+const (
+	defaultWatchInterval = 10 * time.Second
+	minWatchInterval     = time.Second
+)
+
+// Server реализация TrackerService поверх трекера и обработчика вопросов
+type Server struct {
+	trackerpb.UnimplementedTrackerServiceServer
+
+	Tracker *tracker.TrainTracker
+	Handler *api.QuestionHandler
+}
+
+// NewServer создаёт реализацию сервиса
+func NewServer(t *tracker.TrainTracker, h *api.QuestionHandler) *Server {
+	return &Server{Tracker: t, Handler: h}
+}
+
+// NewGRPCServer gRPC-сервер с зарегистрированным TrackerService и reflection
+// (grpcurl и grpcui видят схему без .proto файлов)
+func NewGRPCServer(t *tracker.TrainTracker, h *api.QuestionHandler, opts ...grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(opts...)
+	trackerpb.RegisterTrackerServiceServer(s, NewServer(t, h))
+	reflection.Register(s)
+	return s
+}
+
+// GetPosition позиция поезда
+func (s *Server) GetPosition(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.Position, error) {
+	at := s.requestTime(req.GetAt())
+	pos := s.Tracker.GetCurrentPosition(at)
+	if pos == nil {
+		return nil, errPositionNotFound
+	}
+	return positionProto(at, pos, s.requestLang(ctx, req.GetLang())), nil
+}
+
+// GetStatus стоит поезд или едет
+func (s *Server) GetStatus(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.TrainStatus, error) {
+	at := s.requestTime(req.GetAt())
+	status := s.Tracker.GetTrainStatus(at, s.Tracker.GetCurrentPosition(at))
+	return &trackerpb.TrainStatus{
+		Moving:         status.IsMoving,
+		RemainingStand: durationpb.New(status.RemainingStand),
+		TimeToNext:     durationpb.New(status.TimeToNext),
+	}, nil
+}
+
+// GetJourney день путешествия и смены дат впереди
+func (s *Server) GetJourney(ctx context.Context, req *trackerpb.TimeRequest) (*trackerpb.JourneyInfo, error) {
+	return journeyProto(s.Tracker.GetJourneyInfo(s.requestTime(req.GetAt()))), nil
+}
+
+// WatchPosition поток позиций до отмены клиентом
+func (s *Server) WatchPosition(req *trackerpb.WatchPositionRequest, stream grpc.ServerStreamingServer[trackerpb.Position]) error {
+	interval := defaultWatchInterval
+	if req.GetInterval() != nil {
+		interval = max(req.GetInterval().AsDuration(), minWatchInterval)
+	}
+	lang := s.requestLang(stream.Context(), req.GetLang())
+
+	ticker := s.Tracker.Clock.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last string
+	for {
+		at := s.Tracker.Now()
+		if pos := s.Tracker.GetCurrentPosition(at); pos != nil {
+			key := segmentKey(pos)
+			if !req.GetOnlyChanges() || key != last {
+				if err := stream.Send(positionProto(at, pos, lang)); err != nil {
+					return err
+				}
+				last = key
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C():
+		}
+	}
+}
+
+var errPositionNotFound = status.Error(codes.NotFound, "position not found")
+
+// requestTime момент из запроса; без него - время по часам трекера
+func (s *Server) requestTime(at *timestamppb.Timestamp) time.Time {
+	if at == nil {
+		return s.Tracker.Now()
+	}
+	return at.AsTime()
+}
+
+// requestLang язык ответа: поле lang, затем метаданные accept-language,
+// затем язык обработчика по умолчанию (как ?lang= и Accept-Language в REST)
+func (s *Server) requestLang(ctx context.Context, requested string) i18n.Lang {
+	if lang, ok := i18n.Parse(requested); ok {
+		return lang
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("accept-language"); len(values) > 0 {
+			return i18n.FromAcceptLanguage(values[0])
+		}
+	}
+	return s.Handler.Language
+}
+
+// segmentKey станция или перегон: по нему WatchPosition с only_changes видит смену
+func segmentKey(pos *models.CurrentPosition) string {
+	name := func(s *models.StationInfo) string {
+		if s == nil {
+			return ""
+		}
+		return s.Name
+	}
+	if pos.IsAtStation {
+		return "at:" + name(pos.CurrentStation)
+	}
+	return "between:" + name(pos.PreviousStation) + ">" + name(pos.NextStation)
+}
+
+func positionProto(at time.Time, pos *models.CurrentPosition, lang i18n.Lang) *trackerpb.Position {
+	p := &trackerpb.Position{
+		At:              timestamppb.New(at),
+		AtStation:       pos.IsAtStation,
+		PreviousStation: stationProto(pos.PreviousStation, lang),
+		NextStation:     stationProto(pos.NextStation, lang),
+		DistanceKm:      pos.DistanceFromStart,
+		LocalTime:       localRFC3339(at, pos.Timezone),
+		Timezone:        pos.Timezone,
+	}
+	if pos.IsAtStation {
+		p.CurrentStation = stationProto(pos.CurrentStation, lang)
+	}
+	return p
+}
+
+func stationProto(s *models.StationInfo, lang i18n.Lang) *trackerpb.Station {
+	if s == nil {
+		return nil
+	}
+	return &trackerpb.Station{
+		Id:            int32(s.ID),
+		Name:          s.Name,
+		DisplayName:   i18n.StationName(lang, s.Name),
+		Timezone:      s.Timezone,
+		ArrivalTime:   timestamppb.New(s.ArrivalTime),
+		DepartureTime: timestamppb.New(s.DepartureTime),
+		StandDuration: durationpb.New(s.StandDuration),
+		DistanceKm:    int32(s.DistanceFromStart),
+		Major:         s.IsMajor,
+	}
+}
+
+func journeyProto(info models.JourneyInfo) *trackerpb.JourneyInfo {
+	j := &trackerpb.JourneyInfo{
+		DayNumber:   int32(info.DayNumber),
+		CalendarDay: int32(info.CalendarDay),
+		Timezone:    info.Timezone,
+		Start:       timestamppb.New(info.StartDate),
+		TimeInTrip:  durationpb.New(info.TotalTimeInTrip),
+	}
+	if !info.LocalDate.IsZero() {
+		j.LocalDate = info.LocalDate.Format(time.DateOnly)
+	}
+	for _, change := range info.DateChanges {
+		j.DateChanges = append(j.DateChanges, &trackerpb.DateChange{
+			At:         timestamppb.New(change.At),
+			In:         durationpb.New(change.In),
+			NewDate:    change.NewDate.Format(time.DateOnly),
+			Timezone:   change.Timezone,
+			Station:    change.Station,
+			ClockShift: change.ClockShift,
+		})
+	}
+	return j
+}
+
+// localRFC3339 момент в поясе timezone со смещением: "2025-10-13T02:30:00+09:00"
+func localRFC3339(at time.Time, timezone string) string {
+	local, err := utils.ConvertToTimezone(at, timezone)
+	if err != nil {
+		return at.Format(time.RFC3339)
+	}
+	return local.Format(time.RFC3339)
+}
@@ -0,0 +1,84 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"reyna-train-tracker/internal/tracker/trackertest"
+	"reyna-train-tracker/pkg/trackerclient"
+	"reyna-train-tracker/pkg/trackerpb"
+)
+
+// newTestClient клиент к серверу в памяти (bufconn) на фальшивых часах
+func newTestClient(t *testing.T, at time.Time) *trackerclient.Client {
+	t.Helper()
+
+	trainTracker, handler := trackertest.New(t, at)
+
+	listener := bufconn.Listen(1 << 20)
+	srv := NewGRPCServer(trainTracker, handler)
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+
+	client, err := trackerclient.Dial("passthrough:///bufnet",
+		trackerclient.WithLang("en"),
+		trackerclient.WithDialOptions(
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+		),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestTrackerService(t *testing.T) {
+	at := time.Date(2025, 10, 12, 20, 30, 0, 0, time.FixedZone("MSK", 3*3600))
+	client := newTestClient(t, at)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	pos, err := client.Position(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("Position: %v", err)
+	}
+	if !pos.GetAt().AsTime().Equal(at) || pos.GetTimezone() == "" || pos.GetNextStation() == nil {
+		t.Errorf("unexpected position: %v", pos)
+	}
+
+	answer, err := client.Question(ctx, 2, at)
+	if err != nil {
+		t.Fatalf("Question: %v", err)
+	}
+	if answer.GetText() == "" || answer.GetAnswer().GetFields()["distance_from_moscow"] == nil {
+		t.Errorf("unexpected answer: %v", answer)
+	}
+
+	if _, err := client.Question(ctx, 99, at); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown question: expected NotFound, got %v", err)
+	}
+
+	// Первая позиция приходит сразу, не дожидаясь интервала
+	stop := errors.New("stop")
+	var watched *trackerpb.Position
+	err = client.Watch(ctx, time.Minute, false, func(p *trackerpb.Position) error {
+		watched = p
+		return stop
+	})
+	if !errors.Is(err, stop) || watched.GetNextStation().GetId() != pos.GetNextStation().GetId() {
+		t.Errorf("Watch: err=%v position=%v", err, watched)
+	}
+}
@@ -0,0 +1,368 @@
+package i18n
+
+// catalog сообщения по языкам. Ключ - идентификатор сообщения,
+// значение - шаблон для fmt.Sprintf
+var catalog = map[Lang]map[string]string{
+	Russian: {
+		// Единицы времени (формы множественного числа через "|")
+		"unit.hour":   "час|часа|часов",
+		"unit.minute": "минута|минуты|минут",
+
+		// Ответы на вопросы
+		"answer.status_standing":   "СТОИТ",
+		"answer.status_moving":     "В ПУТИ",
+		"answer.between_stations":  "между станциями",
+		"answer.ahead_of_moscow":   "впереди Москвы",
+		"answer.behind_moscow":     "отстаёт от Москвы",
+		"answer.instant_delivery":  "Сообщение доставляется мгновенно!",
+		"answer.retries_exhausted": "❌ Не удалось обработать вопрос после %d попыток: %v",
+		"answer.same_timezone":     "До конца маршрута часовой пояс не меняется",
+		"answer.side_left":         "слева",
+		"answer.side_right":        "справа",
+		"answer.baikal_ahead":      "впереди",
+		"answer.baikal_now":        "за окном прямо сейчас",
+		"answer.baikal_behind":     "позади",
+		"answer.date_change":       "%s наступит через %s (по часам станции %s)",
+		"answer.date_change_shift": "%s наступит через %s, когда после станции %s переведут часы",
+		"answer.clock_change":      "Часы переведут на %s через %s",
+
+		// Вывод консольного приложения
+		"cli.lang_unsupported":      "⚠️  Язык %q не поддерживается, используется %s",
+		"cli.title":                 "🚂 ТРЕКЕР РЭЙНЫ - Система отслеживания поезда Москва-Хабаровск",
+		"cli.config_error":          "❌ Ошибка загрузки конфигурации: %v",
+		"cli.config_loaded":         "⚙️  Конфигурация загружена:",
+		"cli.config_max_concurrent": "   Максимум одновременных запросов: %d",
+		"cli.config_rate_limit":     "   Лимит запросов в секунду: %d",
+		"cli.config_cache_ttl":      "   Время жизни кэша: %v",
+		"cli.config_cache_size":     "   Размер кэша: %d записей (%s)",
+		"cli.config_workers":        "   Количество воркеров: %d (%s)",
+		"cli.config_retries":        "   Максимум повторов: %d",
+		"cli.schedule_error":        "❌ Ошибка загрузки расписания: %v",
+		"cli.stations_loaded":       "✅ Загружено станций: %d",
+		"cli.total_distance":        "📏 Общая дистанция: %d км",
+		"cli.journey_start":         "🕐 Начало путешествия: %s",
+		"cli.current_time":          "🕐 Текущее время: %s (Москва)",
+		"cli.position_header":       "📍 ТЕКУЩАЯ ПОЗИЦИЯ:",
+		"cli.station":               "🚉 Станция: %s",
+		"cli.distance_from_moscow":  "📏 Расстояние от Москвы: %d км",
+		"cli.timezone":              "🌍 Часовой пояс: %s",
+		"cli.local_time":            "🕐 Локальное время: %s",
+		"cli.between_stations":      "🚂 В пути между станциями:",
+		"cli.previous_station":      "   ├─ Предыдущая: %s",
+		"cli.next_station":          "   └─ Следующая: %s",
+		"cli.approx_distance":       "📏 Приблизительное расстояние от Москвы: %.0f км",
+		"cli.status_moving":         "🚂 Статус: В ДВИЖЕНИИ",
+		"cli.time_to_next":          "⏰ До следующей станции: %s",
+		"cli.status_standing":       "🛑 Статус: СТОИТ НА СТАНЦИИ",
+		"cli.remaining_stand":       "⏰ Осталось стоять: %s",
+		"cli.journey_header":        "📅 ИНФОРМАЦИЯ О ПУТЕШЕСТВИИ:",
+		"cli.journey_day":           "   День путешествия: %d",
+		"cli.calendar_day":          "   День по местному календарю: %d (%s)",
+		"cli.time_in_trip":          "   Время в пути: %s",
+		"cli.position_unknown":      "❌ Не удалось определить текущую позицию",
+		"cli.processing_header":     "🔍 ОБРАБОТКА ВСЕХ ВОПРОСОВ С ИСПОЛЬЗОВАНИЕМ ПАТТЕРНОВ КОНКУРЕНТНОСТИ...",
+		"cli.used_retry":            "✅ Использована улучшенная версия с retry (время: %v)",
+		"cli.used_standard":         "✅ Использована стандартная версия (время: %v)",
+		"cli.stats_header":          "📊 СТАТИСТИКА ИСПОЛЬЗОВАНИЯ:",
+		"cli.total_requests":        "Всего запросов: %v",
+		"cli.cache_size":            "Размер кэша: %v из %v записей",
+		"cli.cache_evictions":       "Вытеснено из кэша: %v, удалено по TTL: %v",
+		"cli.questions_header":      "Запросов по вопросам:",
+		"cli.question_count":        "  Вопрос %d: %d раз(а)",
+		"cli.lb_header":             "📊 СТАТИСТИКА LOAD BALANCER (%s):",
+		"cli.lb_worker":             "  Worker %v: нагрузка = %v, активен = %v, вес = %v, успешно = %v, ошибок = %v",
+		"cli.queue_stats":           "📊 ОЧЕРЕДЬ ВОРКЕРОВ: сейчас = %v, максимум = %v из %v, принято = %v, отклонено = %v",
+		"cli.rate_limiter":          "📊 RATE LIMITER: доступно токенов = %d",
+		"cli.metrics_header":        "📈 МЕТРИКИ ПРОИЗВОДИТЕЛЬНОСТИ:",
+		"cli.metrics_total":         "  Всего обработано запросов: %v",
+		"cli.metrics_avg":           "  Среднее время запроса: %v",
+		"cli.metrics_errors":        "  Процент ошибок: %v",
+		"cli.metrics_hits":          "  Попаданий в кэш: %v",
+		"cli.metrics_misses":        "  Промахов кэша: %v",
+		"cli.metrics_hit_rate":      "  Эффективность кэша: %v",
+		"cli.questions_time":        "  Время обработки вопросов: %v",
+		"cli.total_time":            "⏱️  Общее время выполнения программы: %v",
+		"cli.done":                  "✅ Программа успешно завершена!",
+		"cli.q_local_time":          "   🕐 Локальное время: %v",
+		"cli.q_timezone":            "   🌍 Часовой пояс: %v",
+		"cli.q_station":             "   🚉 Станция: %v",
+		"cli.q_distance":            "   📏 Расстояние от Москвы: %v км",
+		"cli.q_between":             "   🚂 Между станциями:",
+		"cli.q_previous":            "      Предыдущая: %v",
+		"cli.q_next":                "      Следующая: %v",
+		"cli.q_approx_distance":     "   📏 Расстояние от Москвы: ~%v км",
+		"cli.q_stand_duration":      "   ⏰ Время стоянки: %v",
+		"cli.q_remaining_stand":     "   ⏳ Осталось стоять: %v",
+		"cli.q_from":                "   📍 От: %v",
+		"cli.q_to":                  "   📍 До: %v",
+		"cli.q_time_to_next":        "   ⏰ Время до следующей станции: %v",
+		"cli.q_journey_day":         "   📅 День путешествия: %v",
+		"cli.q_calendar_day":        "   📆 День по местному календарю: %v (%v)",
+		"cli.q_next_date_change":    "   🌙 %v",
+		"cli.q_start":               "   🚀 Начало: %v",
+		"cli.q_time_in_trip":        "   ⏱️  Время в пути: %v",
+		"cli.q_location":            "   📍 Местоположение: %v",
+		"cli.q_next_station":        "   🚉 Следующая станция: %v",
+		"cli.q_arrival_time":        "   ⏰ Время прибытия: %v",
+		"cli.q_scheduled_time":      "   🗓  По расписанию: %v",
+		"cli.q_arrival_window":      "   📊 Интервал (%v): %v",
+		"cli.q_delay":               "   ⏳ Отклонение от расписания: %v",
+		"cli.q_time_remaining":      "   ⏳ Осталось в пути: %v",
+		"cli.q_moscow_time":         "   🕐 Время в Москве: %v",
+		"cli.q_difference":          "   ⏰ Разница: %v",
+		"cli.q_clock_change":        "   ⚠️  %v",
+		"cli.q_send_moscow":         "   📱 Время отправки (Москва): %v",
+		"cli.q_receive_her":         "   📨 Время получения (у неё): %v",
+		"cli.q_send_her":            "   📱 Время отправки (у неё): %v",
+		"cli.q_receive_moscow":      "   📨 Время получения (Москва): %v",
+		"cli.q_major_ahead":         "   🚉 Основных станций впереди: %v",
+		"cli.q_more_stations":       "   ... и ещё %d станций",
+		"cli.q_arrival":             "     ⏰ Прибытие: %v",
+		"cli.q_stand":               "     🕐 Стоянка: %v",
+		"cli.q_station_distance":    "     📏 Расстояние: %v км",
+
+		// Расписание (cmd/timeline)
+		"timeline.title":         "Расписание: %s",
+		"timeline.summary":       "Отправление %s (МСК), %d станций, %d км",
+		"timeline.legend":        "★ - крупная станция; время прибытия и отправления по Москве и по местным часам",
+		"timeline.col_number":    "№",
+		"timeline.col_station":   "Станция",
+		"timeline.col_km":        "Км",
+		"timeline.col_day":       "День",
+		"timeline.col_arrival":   "Прибытие (МСК)",
+		"timeline.col_departure": "Отправление (МСК)",
+		"timeline.col_local_arr": "Прибытие (местное)",
+		"timeline.col_local_dep": "Отправление (местное)",
+		"timeline.col_stand":     "Стоянка",
+		"timeline.col_timezone":  "Пояс",
+		"timeline.moscow_offset": "МСК%+d",
+
+		// Панель в терминале (cmd/tui)
+		"tui.title":       "🚂 %s",
+		"tui.progress":    "%.0f из %d км (%.1f%%)",
+		"tui.not_started": "🕐 Поезд ещё не отправился: отправление через %s",
+		"tui.finished":    "🏁 Поезд прибыл на станцию %s",
+		"tui.at_station":  "🚉 Стоянка: %s",
+		"tui.stand_left":  "⏳ До отправления: %s",
+		"tui.moving":      "🚂 В пути: %s → %s",
+		"tui.next":        "⏰ До станции %s: %s",
+		"tui.local":       "🕐 Местное время: %s (%s)",
+		"tui.moscow":      "🏛  Москва: %s",
+		"tui.day":         "📅 День поездки: %d",
+		"tui.upcoming":    "🚉 Крупные станции впереди:",
+		"tui.station_row": "   %-28s %s  %5v км  (%v)",
+		"tui.none":        "   —",
+		"tui.live":        "▶ Текущее время",
+		"tui.shifted":     "⏩ Сдвиг: %s",
+		"tui.paused":      "⏸ Пауза",
+		"tui.keys":        "←/→ ±10 мин  ↑/↓ ±1 ч  PgUp/PgDn ±1 день  n/p станция вперёд/назад  пробел пауза  r сейчас  q выход",
+		"tui.no_terminal": "❌ Панели нужен терминал: запустите без перенаправления ввода и вывода",
+
+		// Бот для семейного чата (cmd/bot)
+		"bot.help":               "Команды:\n/where - где сейчас поезд\n/time - который час у неё и в Москве\n/next - когда следующая станция\n/call - когда удобно позвонить\n/start - присылать события поездки в этот чат\n/stop - не присылать события",
+		"bot.subscribed":         "🚂 Буду присылать сюда прибытия на крупные станции, переводы часов и смены дат.",
+		"bot.unsubscribed":       "🔕 Больше не присылаю события поездки в этот чат.",
+		"bot.unknown":            "🤔 Не знаю команду %s. /help - список команд",
+		"bot.where_station":      "🚉 Стоянка на станции %v, %v км от Москвы",
+		"bot.where_between":      "🚂 В пути: %v → %v, %v км от Москвы",
+		"bot.time_local":         "🕐 У неё %v (%v)",
+		"bot.time_moscow":        "🏛 В Москве %v, разница %v (%v)",
+		"bot.next":               "⏰ Следующая станция %v: прибытие в %v МСК, через %v",
+		"bot.next_scheduled":     "🗓 По расписанию %v, интервал %v",
+		"bot.next_delay":         "⏳ Отклонение от расписания: %v",
+		"bot.call_header":        "📞 Когда удобно звонить (в Москве / у неё):",
+		"bot.call_window":        "• %s-%s / %s-%s (%s)",
+		"bot.call_stops":         "   🚉 стоянки: %s",
+		"bot.call_none":          "📵 В ближайшие сутки нет времени, когда не ночь и в Москве, и у неё",
+		"bot.no_route":           "❌ Маршрут не загружен",
+		"bot.not_departed":       "🕐 Поезд ещё не отправился со станции %s: отправление через %s",
+		"bot.arrived":            "🏁 Поезд прибыл на станцию %s",
+		"bot.push_arrival":       "🚉 Прибытие: %s (местное время %s)",
+		"bot.push_departure":     "🚂 Отправление: %s (местное время %s)",
+		"bot.push_timezone":      "🌍 Новый часовой пояс %s: часы переводятся на %s, у неё теперь %s",
+		"bot.push_timezone_same": "🌍 Новый часовой пояс %s, часы не переводятся",
+		"bot.push_date":          "📅 У неё наступило %s (в Москве %s)",
+		"bot.fake_ready":         "🧪 Фальшивый Bot API: %s. Пишите команды, /quit - выход",
+	},
+	English: {
+		// Единицы времени (формы множественного числа через "|")
+		"unit.hour":   "hour|hours",
+		"unit.minute": "minute|minutes",
+
+		// Ответы на вопросы
+		"answer.status_standing":   "STANDING",
+		"answer.status_moving":     "MOVING",
+		"answer.between_stations":  "between stations",
+		"answer.ahead_of_moscow":   "ahead of Moscow",
+		"answer.behind_moscow":     "behind Moscow",
+		"answer.instant_delivery":  "Messages are delivered instantly!",
+		"answer.retries_exhausted": "❌ Failed to process the question after %d attempts: %v",
+		"answer.same_timezone":     "The timezone does not change until the end of the route",
+		"answer.side_left":         "left",
+		"answer.side_right":        "right",
+		"answer.baikal_ahead":      "ahead",
+		"answer.baikal_now":        "outside the window right now",
+		"answer.baikal_behind":     "behind",
+		"answer.date_change":       "%s begins in %s (%s local time)",
+		"answer.date_change_shift": "%s begins in %s when clocks change after %s",
+		"answer.clock_change":      "Clocks go %s in %s",
+
+		// Вывод консольного приложения
+		"cli.lang_unsupported":      "⚠️  Language %q is not supported, using %s",
+		"cli.title":                 "🚂 REYNA TRACKER - Moscow-Khabarovsk train tracking system",
+		"cli.config_error":          "❌ Failed to load configuration: %v",
+		"cli.config_loaded":         "⚙️  Configuration loaded:",
+		"cli.config_max_concurrent": "   Max concurrent requests: %d",
+		"cli.config_rate_limit":     "   Requests per second limit: %d",
+		"cli.config_cache_ttl":      "   Cache TTL: %v",
+		"cli.config_cache_size":     "   Cache size: %d entries (%s)",
+		"cli.config_workers":        "   Workers: %d (%s)",
+		"cli.config_retries":        "   Max retries: %d",
+		"cli.schedule_error":        "❌ Failed to load the schedule: %v",
+		"cli.stations_loaded":       "✅ Stations loaded: %d",
+		"cli.total_distance":        "📏 Total distance: %d km",
+		"cli.journey_start":         "🕐 Journey start: %s",
+		"cli.current_time":          "🕐 Current time: %s (Moscow)",
+		"cli.position_header":       "📍 CURRENT POSITION:",
+		"cli.station":               "🚉 Station: %s",
+		"cli.distance_from_moscow":  "📏 Distance from Moscow: %d km",
+		"cli.timezone":              "🌍 Timezone: %s",
+		"cli.local_time":            "🕐 Local time: %s",
+		"cli.between_stations":      "🚂 Between stations:",
+		"cli.previous_station":      "   ├─ Previous: %s",
+		"cli.next_station":          "   └─ Next: %s",
+		"cli.approx_distance":       "📏 Approximate distance from Moscow: %.0f km",
+		"cli.status_moving":         "🚂 Status: MOVING",
+		"cli.time_to_next":          "⏰ Time to next station: %s",
+		"cli.status_standing":       "🛑 Status: STANDING AT A STATION",
+		"cli.remaining_stand":       "⏰ Stand time left: %s",
+		"cli.journey_header":        "📅 JOURNEY INFO:",
+		"cli.journey_day":           "   Journey day: %d",
+		"cli.calendar_day":          "   Local calendar day: %d (%s)",
+		"cli.time_in_trip":          "   Time on the road: %s",
+		"cli.position_unknown":      "❌ Could not determine the current position",
+		"cli.processing_header":     "🔍 PROCESSING ALL QUESTIONS WITH CONCURRENCY PATTERNS...",
+		"cli.used_retry":            "✅ Used the improved version with retries (time: %v)",
+		"cli.used_standard":         "✅ Used the standard version (time: %v)",
+		"cli.stats_header":          "📊 USAGE STATISTICS:",
+		"cli.total_requests":        "Total requests: %v",
+		"cli.cache_size":            "Cache size: %v of %v entries",
+		"cli.cache_evictions":       "Evicted from cache: %v, expired by TTL: %v",
+		"cli.questions_header":      "Requests per question:",
+		"cli.question_count":        "  Question %d: %d time(s)",
+		"cli.lb_header":             "📊 LOAD BALANCER STATISTICS (%s):",
+		"cli.lb_worker":             "  Worker %v: load = %v, active = %v, weight = %v, succeeded = %v, failed = %v",
+		"cli.queue_stats":           "📊 WORKER QUEUE: now = %v, max = %v of %v, accepted = %v, rejected = %v",
+		"cli.rate_limiter":          "📊 RATE LIMITER: tokens available = %d",
+		"cli.metrics_header":        "📈 PERFORMANCE METRICS:",
+		"cli.metrics_total":         "  Total requests processed: %v",
+		"cli.metrics_avg":           "  Average request time: %v",
+		"cli.metrics_errors":        "  Error rate: %v",
+		"cli.metrics_hits":          "  Cache hits: %v",
+		"cli.metrics_misses":        "  Cache misses: %v",
+		"cli.metrics_hit_rate":      "  Cache efficiency: %v",
+		"cli.questions_time":        "  Time to process the questions: %v",
+		"cli.total_time":            "⏱️  Total run time: %v",
+		"cli.done":                  "✅ The program finished successfully!",
+		"cli.q_local_time":          "   🕐 Local time: %v",
+		"cli.q_timezone":            "   🌍 Timezone: %v",
+		"cli.q_station":             "   🚉 Station: %v",
+		"cli.q_distance":            "   📏 Distance from Moscow: %v km",
+		"cli.q_between":             "   🚂 Between stations:",
+		"cli.q_previous":            "      Previous: %v",
+		"cli.q_next":                "      Next: %v",
+		"cli.q_approx_distance":     "   📏 Distance from Moscow: ~%v km",
+		"cli.q_stand_duration":      "   ⏰ Stand duration: %v",
+		"cli.q_remaining_stand":     "   ⏳ Stand time left: %v",
+		"cli.q_from":                "   📍 From: %v",
+		"cli.q_to":                  "   📍 To: %v",
+		"cli.q_time_to_next":        "   ⏰ Time to next station: %v",
+		"cli.q_journey_day":         "   📅 Journey day: %v",
+		"cli.q_calendar_day":        "   📆 Local calendar day: %v (%v)",
+		"cli.q_next_date_change":    "   🌙 %v",
+		"cli.q_start":               "   🚀 Start: %v",
+		"cli.q_time_in_trip":        "   ⏱️  Time on the road: %v",
+		"cli.q_location":            "   📍 Location: %v",
+		"cli.q_next_station":        "   🚉 Next station: %v",
+		"cli.q_arrival_time":        "   ⏰ Arrival time: %v",
+		"cli.q_scheduled_time":      "   🗓  Scheduled: %v",
+		"cli.q_arrival_window":      "   📊 Window (%v): %v",
+		"cli.q_delay":               "   ⏳ Off schedule by: %v",
+		"cli.q_time_remaining":      "   ⏳ Time left: %v",
+		"cli.q_moscow_time":         "   🕐 Moscow time: %v",
+		"cli.q_difference":          "   ⏰ Difference: %v",
+		"cli.q_clock_change":        "   ⚠️  %v",
+		"cli.q_send_moscow":         "   📱 Sent at (Moscow): %v",
+		"cli.q_receive_her":         "   📨 Received at (her time): %v",
+		"cli.q_send_her":            "   📱 Sent at (her time): %v",
+		"cli.q_receive_moscow":      "   📨 Received at (Moscow): %v",
+		"cli.q_major_ahead":         "   🚉 Major stations ahead: %v",
+		"cli.q_more_stations":       "   ... and %d more stations",
+		"cli.q_arrival":             "     ⏰ Arrival: %v",
+		"cli.q_stand":               "     🕐 Stand: %v",
+		"cli.q_station_distance":    "     📏 Distance: %v km",
+
+		// Расписание (cmd/timeline)
+		"timeline.title":         "Timetable: %s",
+		"timeline.summary":       "Departs %s (Moscow time), %d stations, %d km",
+		"timeline.legend":        "★ - major station; arrival and departure in Moscow and local time",
+		"timeline.col_number":    "#",
+		"timeline.col_station":   "Station",
+		"timeline.col_km":        "Km",
+		"timeline.col_day":       "Day",
+		"timeline.col_arrival":   "Arrival (MSK)",
+		"timeline.col_departure": "Departure (MSK)",
+		"timeline.col_local_arr": "Arrival (local)",
+		"timeline.col_local_dep": "Departure (local)",
+		"timeline.col_stand":     "Stop",
+		"timeline.col_timezone":  "Timezone",
+		"timeline.moscow_offset": "MSK%+d",
+
+		// Панель в терминале (cmd/tui)
+		"tui.title":       "🚂 %s",
+		"tui.progress":    "%.0f of %d km (%.1f%%)",
+		"tui.not_started": "🕐 The train has not departed yet: departure in %s",
+		"tui.finished":    "🏁 The train has arrived at %s",
+		"tui.at_station":  "🚉 Stopped at: %s",
+		"tui.stand_left":  "⏳ Departure in: %s",
+		"tui.moving":      "🚂 On the way: %s → %s",
+		"tui.next":        "⏰ To %s: %s",
+		"tui.local":       "🕐 Local time: %s (%s)",
+		"tui.moscow":      "🏛  Moscow: %s",
+		"tui.day":         "📅 Day of the trip: %d",
+		"tui.upcoming":    "🚉 Major stations ahead:",
+		"tui.station_row": "   %-28s %s  %5v km  (%v)",
+		"tui.none":        "   —",
+		"tui.live":        "▶ Live",
+		"tui.shifted":     "⏩ Shifted by: %s",
+		"tui.paused":      "⏸ Paused",
+		"tui.keys":        "←/→ ±10 min  ↑/↓ ±1 h  PgUp/PgDn ±1 day  n/p next/previous station  space pause  r now  q quit",
+		"tui.no_terminal": "❌ The dashboard needs a terminal: run it without redirecting input and output",
+
+		// Бот для семейного чата (cmd/bot)
+		"bot.help":               "Commands:\n/where - where the train is now\n/time - her time and Moscow time\n/next - when the next station is\n/call - when it is a good time to call\n/start - post trip events to this chat\n/stop - stop posting trip events",
+		"bot.subscribed":         "🚂 I will post arrivals at major stations, clock changes and new dates here.",
+		"bot.unsubscribed":       "🔕 I will no longer post trip events to this chat.",
+		"bot.unknown":            "🤔 Unknown command %s. /help lists the commands",
+		"bot.where_station":      "🚉 Stopped at %v, %v km from Moscow",
+		"bot.where_between":      "🚂 On the way: %v → %v, %v km from Moscow",
+		"bot.time_local":         "🕐 Her time: %v (%v)",
+		"bot.time_moscow":        "🏛 Moscow: %v, difference %v (%v)",
+		"bot.next":               "⏰ Next station %v: arrival at %v Moscow time, in %v",
+		"bot.next_scheduled":     "🗓 Scheduled at %v, window %v",
+		"bot.next_delay":         "⏳ Deviation from the timetable: %v",
+		"bot.call_header":        "📞 Good times to call (Moscow / hers):",
+		"bot.call_window":        "• %s-%s / %s-%s (%s)",
+		"bot.call_stops":         "   🚉 stops: %s",
+		"bot.call_none":          "📵 No time in the next 24 hours when it is daytime both in Moscow and for her",
+		"bot.no_route":           "❌ The route is not loaded",
+		"bot.not_departed":       "🕐 The train has not left %s yet: departure in %s",
+		"bot.arrived":            "🏁 The train has arrived at %s",
+		"bot.push_arrival":       "🚉 Arrived at %s (local time %s)",
+		"bot.push_departure":     "🚂 Departed from %s (local time %s)",
+		"bot.push_timezone":      "🌍 New timezone %s: clocks move %s, her time is now %s",
+		"bot.push_timezone_same": "🌍 New timezone %s, clocks stay the same",
+		"bot.push_date":          "📅 A new day for her: %s (Moscow %s)",
+		"bot.fake_ready":         "🧪 Fake Bot API: %s. Type commands, /quit to exit",
+	},
+}
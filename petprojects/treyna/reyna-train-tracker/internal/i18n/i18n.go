@@ -0,0 +1,103 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Lang код языка (ISO 639-1)
+type Lang string
+
+const (
+	Russian Lang = "ru"
+	English Lang = "en"
+
+	// Default язык, на который откатываемся, если перевода нет
+	Default = Russian
+)
+
+// Supported возвращает языки, для которых есть каталог сообщений
+func Supported() []Lang {
+	return []Lang{Russian, English}
+}
+
+// Parse разбирает код языка в любом привычном виде: "en", "en-US", "en_US.UTF-8".
+// Возвращает false, если язык не поддерживается
+func Parse(s string) (Lang, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexAny(s, "-_.@"); i >= 0 {
+		s = s[:i]
+	}
+
+	lang := Lang(s)
+	if _, ok := catalog[lang]; !ok {
+		return "", false
+	}
+	return lang, true
+}
+
+// ParseOrDefault как Parse, но для неподдерживаемого языка возвращает Default
+func ParseOrDefault(s string) Lang {
+	if lang, ok := Parse(s); ok {
+		return lang
+	}
+	return Default
+}
+
+// FromAcceptLanguage выбирает поддерживаемый язык из заголовка Accept-Language
+// с учётом весов: "en-US,en;q=0.9,ru;q=0.8" -> en
+func FromAcceptLanguage(header string) Lang {
+	type candidate struct {
+		lang    Lang
+		quality float64
+		order   int
+	}
+
+	var candidates []candidate
+	for i, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang, ok := Parse(tag)
+		if !ok {
+			continue
+		}
+
+		quality := 1.0
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		if quality > 0 {
+			candidates = append(candidates, candidate{lang: lang, quality: quality, order: i})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return Default
+	}
+
+	// При равных весах побеждает язык, указанный раньше
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	return candidates[0].lang
+}
+
+// T возвращает сообщение из каталога, подставляя аргументы как в fmt.Sprintf.
+// Если перевода нет, берётся сообщение на языке по умолчанию, а если нет и его - сам ключ
+func T(lang Lang, key string, args ...interface{}) string {
+	message, ok := catalog[lang][key]
+	if !ok {
+		message, ok = catalog[Default][key]
+	}
+	if !ok {
+		message = key
+	}
+
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
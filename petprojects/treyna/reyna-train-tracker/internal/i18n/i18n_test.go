@@ -0,0 +1,102 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		lang Lang
+		d    time.Duration
+		want string
+	}{
+		{Russian, time.Hour, "1 час"},
+		{Russian, 2*time.Hour + 5*time.Minute, "2 часа 5 минут"},
+		{Russian, 11 * time.Hour, "11 часов"},
+		{Russian, 21 * time.Hour, "21 час"},
+		{Russian, 22*time.Hour + 1*time.Minute, "22 часа 1 минута"},
+		{Russian, 14 * time.Minute, "14 минут"},
+		{Russian, 0, "0 минут"},
+		{Russian, -3 * time.Minute, "-3 минуты"},
+		{English, time.Hour, "1 hour"},
+		{English, 21 * time.Minute, "21 minutes"},
+		{English, 25*time.Hour + time.Minute, "25 hours 1 minute"},
+	}
+	for _, tt := range tests {
+		if got := FormatDuration(tt.lang, tt.d); got != tt.want {
+			t.Errorf("FormatDuration(%s, %v) = %q, want %q", tt.lang, tt.d, got, tt.want)
+		}
+	}
+
+	if got := FormatOffset(Russian, 2*time.Hour); got != "+2 часа" {
+		t.Errorf("FormatOffset = %q", got)
+	}
+	if got := FormatOffset(English, -time.Hour); got != "-1 hour" {
+		t.Errorf("FormatOffset = %q", got)
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   Lang
+		accept string // Заголовок Accept-Language
+		header Lang
+	}{
+		{"en", English, "en-US,en;q=0.9,ru;q=0.8", English},
+		{"en_US.UTF-8", English, "ru;q=0.5,en;q=0.9", English},
+		{" RU-ru ", Russian, "de,fr;q=0.9", Default},
+		{"de", Default, "en;q=0,ru", Russian},
+		{"", Default, "", Default},
+	}
+	for _, tt := range tests {
+		if got := ParseOrDefault(tt.input); got != tt.want {
+			t.Errorf("ParseOrDefault(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+		if got := FromAcceptLanguage(tt.accept); got != tt.header {
+			t.Errorf("FromAcceptLanguage(%q) = %s, want %s", tt.accept, got, tt.header)
+		}
+	}
+}
+
+func TestStationName(t *testing.T) {
+	tests := []struct {
+		lang Lang
+		name string
+		want string
+	}{
+		{Russian, "Екатеринбург", "Екатеринбург"},
+		{English, "Екатеринбург", "Yekaterinburg"},
+		{English, "Улан-Удэ", "Ulan-Ude"},
+		{English, "Слюдянка 1", "Slyudyanka 1"},
+		{English, "Ерофей Павлович", "Yerofey Pavlovich"},
+		{English, "Хабаровск 1", "Khabarovsk 1"},
+		{English, "Moskva", "Moskva"},
+	}
+	for _, tt := range tests {
+		if got := StationName(tt.lang, tt.name); got != tt.want {
+			t.Errorf("StationName(%s, %q) = %q, want %q", tt.lang, tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestCatalogComplete у каждого ключа есть перевод на все языки
+func TestCatalogComplete(t *testing.T) {
+	for _, lang := range Supported() {
+		for key := range catalog[Default] {
+			if _, ok := catalog[lang][key]; !ok {
+				t.Errorf("%s: missing %q", lang, key)
+			}
+		}
+		for key := range catalog[lang] {
+			if _, ok := catalog[Default][key]; !ok {
+				t.Errorf("%s: %q is missing in the default language", lang, key)
+			}
+		}
+	}
+
+	if got := T(English, "no.such.key"); got != "no.such.key" {
+		t.Errorf("unknown key must fall back to itself, got %q", got)
+	}
+}
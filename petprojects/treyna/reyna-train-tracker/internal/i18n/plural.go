@@ -0,0 +1,69 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// pluralIndex номер формы множественного числа для n.
+// Русский: 1 час, 2 часа, 5 часов; английский: 1 hour, 2 hours
+func pluralIndex(lang Lang, n int) int {
+	if n < 0 {
+		n = -n
+	}
+
+	switch lang {
+	case Russian:
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return 1
+		default:
+			return 2
+		}
+	default:
+		if n == 1 {
+			return 0
+		}
+		return 1
+	}
+}
+
+// Plural возвращает "n слово" в нужной форме.
+// Формы в каталоге перечисляются через "|": "час|часа|часов", "hour|hours"
+func Plural(lang Lang, n int, key string) string {
+	forms := strings.Split(T(lang, key), "|")
+	index := pluralIndex(lang, n)
+	if index >= len(forms) {
+		index = len(forms) - 1
+	}
+	return fmt.Sprintf("%d %s", n, forms[index])
+}
+
+// FormatDuration форматирует длительность словами: "2 часа 5 минут", "1 hour", "21 minutes"
+func FormatDuration(lang Lang, d time.Duration) string {
+	if d < 0 {
+		return "-" + FormatDuration(lang, -d)
+	}
+
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+
+	switch {
+	case hours > 0 && minutes == 0:
+		return Plural(lang, hours, "unit.hour")
+	case hours > 0:
+		return Plural(lang, hours, "unit.hour") + " " + Plural(lang, minutes, "unit.minute")
+	}
+	return Plural(lang, minutes, "unit.minute")
+}
+
+// FormatOffset форматирует сдвиг часов со знаком: "+1 час", "-2 hours"
+func FormatOffset(lang Lang, d time.Duration) string {
+	if d >= 0 {
+		return "+" + FormatDuration(lang, d)
+	}
+	return FormatDuration(lang, d)
+}
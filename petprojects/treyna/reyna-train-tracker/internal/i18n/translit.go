@@ -0,0 +1,59 @@
+package i18n
+
+import (
+	"strings"
+	"unicode"
+)
+
+// translitTable латиница для строчных русских букв (упрощённая BGN/PCGN)
+var translitTable = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// isVowelOrSign после этих букв "е" читается как "ye"
+func isVowelOrSign(r rune) bool {
+	return strings.ContainsRune("аеёиоуыэюяъь", unicode.ToLower(r))
+}
+
+// Transliterate переводит кириллицу в латиницу: "Улан-Удэ" -> "Ulan-Ude",
+// "Екатеринбург" -> "Yekaterinburg". Остальные символы не меняются
+func Transliterate(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	prev := ' '
+	for _, r := range s {
+		lower := unicode.ToLower(r)
+		latin, ok := translitTable[lower]
+		if !ok {
+			b.WriteRune(r)
+			prev = r
+			continue
+		}
+
+		// В начале слова и после гласной "е" звучит как "ye"
+		if lower == 'е' && (!unicode.IsLetter(prev) || isVowelOrSign(prev)) {
+			latin = "ye"
+		}
+
+		if r != lower && latin != "" {
+			latin = strings.ToUpper(latin[:1]) + latin[1:]
+		}
+		b.WriteString(latin)
+		prev = r
+	}
+
+	return b.String()
+}
+
+// StationName название станции для языка: по-русски как есть, иначе транслитом
+func StationName(lang Lang, name string) string {
+	if lang == Russian {
+		return name
+	}
+	return Transliterate(name)
+}
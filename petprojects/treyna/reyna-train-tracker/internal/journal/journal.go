@@ -0,0 +1,235 @@
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// EventType тип наблюдаемого события
+type EventType string
+
+const (
+	EventArrival       EventType = "arrival"        // Фактическое прибытие на станцию
+	EventDeparture     EventType = "departure"      // Фактическое отправление со станции
+	EventDelay         EventType = "delay"          // Сообщение об опоздании (например, от проводника)
+	EventPositionQuery EventType = "position_query" // Запрос позиции и что трекер ответил
+)
+
+// Event одно наблюдение за поездкой
+type Event struct {
+	Seq        uint64        `json:"seq"`
+	Type       EventType     `json:"type"`
+	At         time.Time     `json:"at"`          // Когда событие произошло
+	RecordedAt time.Time     `json:"recorded_at"` // Когда его записали
+	StationID  int           `json:"station_id,omitempty"`
+	Station    string        `json:"station,omitempty"`
+	Delay      time.Duration `json:"delay,omitempty"`       // Для EventDelay
+	DistanceKm float64       `json:"distance_km,omitempty"` // Для EventPositionQuery
+	Note       string        `json:"note,omitempty"`
+}
+
+// Validate проверяет, что событие можно записать
+func (e Event) Validate() error {
+	switch e.Type {
+	case EventArrival, EventDeparture:
+		if e.StationID == 0 && e.Station == "" {
+			return fmt.Errorf("%s event requires a station", e.Type)
+		}
+	case EventDelay, EventPositionQuery:
+	default:
+		return fmt.Errorf("unknown event type: %q", e.Type)
+	}
+
+	if e.At.IsZero() {
+		return errors.New("event time is required")
+	}
+	return nil
+}
+
+// Recorder хранилище событий поездки: файл журнала (Log) или база данных
+type Recorder interface {
+	Append(e Event) (Event, error)
+	Events() ([]Event, error)
+	Close() error
+}
+
+// ValidTripID проверяет, что ID поездки годится в имя файла или ключ
+func ValidTripID(tripID string) bool {
+	return tripIDPattern.MatchString(tripID)
+}
+
+// tripIDPattern ID поездки становится именем файла, поэтому без разделителей пути
+var tripIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// Log журнал событий одной поездки: файл JSON Lines, в который только дописывают
+// Паттерн: Append-only log - записи никогда не изменяются и не удаляются
+type Log struct {
+	TripID string
+
+	path string
+	mu   sync.Mutex
+	file *os.File
+	seq  uint64 // Номер последней записанной строки
+}
+
+// Open открывает (или создаёт) журнал поездки tripID в каталоге dir
+func Open(dir, tripID string) (*Log, error) {
+	if !ValidTripID(tripID) {
+		return nil, fmt.Errorf("invalid trip id: %q", tripID)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create journal dir: %w", err)
+	}
+
+	path := filepath.Join(dir, tripID+".jsonl")
+	if err := repairTail(path); err != nil {
+		return nil, err
+	}
+
+	// Продолжаем нумерацию с последней записи
+	existing, err := ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open journal: %w", err)
+	}
+
+	l := &Log{TripID: tripID, path: path, file: file}
+	if n := len(existing); n > 0 {
+		l.seq = existing[n-1].Seq
+	}
+	return l, nil
+}
+
+// Path путь к файлу журнала
+func (l *Log) Path() string {
+	return l.path
+}
+
+// Append дописывает событие и возвращает его с присвоенным номером
+func (l *Log) Append(e Event) (Event, error) {
+	if err := e.Validate(); err != nil {
+		return Event{}, err
+	}
+	if e.RecordedAt.IsZero() {
+		e.RecordedAt = time.Now()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return Event{}, errors.New("journal is closed")
+	}
+
+	e.Seq = l.seq + 1
+	line, err := json.Marshal(e)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	// Одна строка - один вызов write, так строки разных записей не перемешаются
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return Event{}, fmt.Errorf("failed to append event: %w", err)
+	}
+
+	l.seq = e.Seq
+	return e, nil
+}
+
+// Events читает все события журнала
+func (l *Log) Events() ([]Event, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return ReadFile(l.path)
+}
+
+// Close сбрасывает журнал на диск и закрывает файл
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	syncErr := l.file.Sync()
+	closeErr := l.file.Close()
+	l.file = nil
+	return errors.Join(syncErr, closeErr)
+}
+
+// ReadFile читает события из файла журнала.
+// Недописанная последняя строка (обрыв при записи) пропускается,
+// испорченная строка в середине - ошибка
+func ReadFile(path string) ([]Event, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			if !bytes.HasSuffix(data, []byte("\n")) && isLastLine(data, line) {
+				break
+			}
+			return nil, fmt.Errorf("journal %s line %d: %w", path, lineNum, err)
+		}
+		events = append(events, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read journal: %w", err)
+	}
+
+	return events, nil
+}
+
+// repairTail обрезает недописанную последнюю строку, оставшуюся после сбоя,
+// иначе следующая запись склеилась бы с ней в одну испорченную строку
+func repairTail(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read journal: %w", err)
+	}
+	if len(data) == 0 || data[len(data)-1] == '\n' {
+		return nil
+	}
+
+	keep := bytes.LastIndexByte(data, '\n') + 1
+	if err := os.Truncate(path, int64(keep)); err != nil {
+		return fmt.Errorf("failed to repair journal: %w", err)
+	}
+	return nil
+}
+
+// isLastLine проверяет, что line - последняя строка data
+func isLastLine(data, line []byte) bool {
+	return bytes.HasSuffix(bytes.TrimSpace(data), line)
+}
@@ -0,0 +1,160 @@
+package journal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/models"
+)
+
+var start = time.Date(2025, 10, 12, 20, 0, 0, 0, time.UTC)
+
+// testStations три станции: A (отправление), B (стоянка 10 минут), C (прибытие)
+func testStations() []models.StationInfo {
+	return []models.StationInfo{
+		{ID: 1, Name: "A", DepartureTime: start},
+		{ID: 2, Name: "B", ArrivalTime: start.Add(2 * time.Hour), DepartureTime: start.Add(2*time.Hour + 10*time.Minute), DistanceFromStart: 200},
+		{ID: 3, Name: "C", ArrivalTime: start.Add(4 * time.Hour), DistanceFromStart: 400},
+	}
+}
+
+// TestReopenContinuesJournal журнал переживает перезапуск: нумерация продолжается,
+// а строка, недописанная при сбое, отбрасывается
+func TestReopenContinuesJournal(t *testing.T) {
+	tests := []struct {
+		name     string
+		tail     string // Что осталось в конце файла после "сбоя"
+		wantSeqs []uint64
+		wantErr  bool
+	}{
+		{name: "clean restart", wantSeqs: []uint64{1, 2, 3}},
+		{name: "torn last line", tail: `{"seq":3,"type":"arr`, wantSeqs: []uint64{1, 2, 3}},
+		{name: "corrupt line", tail: "garbage\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			log, err := Open(dir, "2025-10-12")
+			if err != nil {
+				t.Fatal(err)
+			}
+			log.Append(Event{Type: EventDeparture, At: start, StationID: 1})
+			log.Append(Event{Type: EventDelay, At: start.Add(time.Hour), Delay: 5 * time.Minute})
+			log.Close()
+
+			if tt.tail != "" {
+				f, _ := os.OpenFile(filepath.Join(dir, "2025-10-12.jsonl"), os.O_APPEND|os.O_WRONLY, 0o644)
+				f.WriteString(tt.tail)
+				f.Close()
+			}
+
+			reopened, err := Open(dir, "2025-10-12")
+			if tt.wantErr {
+				if err == nil {
+					reopened.Close()
+					t.Fatal("corrupt journal must not open")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer reopened.Close()
+			if _, err := reopened.Append(Event{Type: EventArrival, At: start.Add(2 * time.Hour), Station: "B"}); err != nil {
+				t.Fatal(err)
+			}
+
+			events, err := reopened.Events()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var seqs []uint64
+			for _, e := range events {
+				seqs = append(seqs, e.Seq)
+			}
+			if len(seqs) != len(tt.wantSeqs) || seqs[len(seqs)-1] != tt.wantSeqs[len(tt.wantSeqs)-1] {
+				t.Errorf("seqs %v, want %v", seqs, tt.wantSeqs)
+			}
+		})
+	}
+}
+
+func TestAppendValidates(t *testing.T) {
+	log, err := Open(t.TempDir(), "trip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer log.Close()
+
+	tests := []struct {
+		name  string
+		event Event
+		ok    bool
+	}{
+		{"arrival by name", Event{Type: EventArrival, At: start, Station: "B"}, true},
+		{"arrival without station", Event{Type: EventArrival, At: start}, false},
+		{"delay without time", Event{Type: EventDelay}, false},
+		{"unknown type", Event{Type: "teleport", At: start}, false},
+	}
+	for _, tt := range tests {
+		if _, err := log.Append(tt.event); (err == nil) != tt.ok {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+	}
+	if _, err := Open(t.TempDir(), "../escape"); err == nil {
+		t.Error("trip id with a path separator must be rejected")
+	}
+}
+
+// TestReplayAfterRestart события, прочитанные из файла, дают те же опоздания,
+// что и записанные: порядок - по времени события, а не записи
+func TestReplayAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	log, _ := Open(dir, "trip")
+	// Отправление из A записано с опозданием, уже после сообщения проводника
+	log.Append(Event{Type: EventDelay, At: start.Add(time.Hour), Delay: 15 * time.Minute})
+	log.Append(Event{Type: EventDeparture, At: start.Add(5 * time.Minute), StationID: 1})
+	log.Append(Event{Type: EventArrival, At: start.Add(2*time.Hour + 20*time.Minute), Station: "B"})
+	log.Append(Event{Type: EventDeparture, At: start.Add(2*time.Hour + 25*time.Minute), StationID: 2})
+	log.Append(Event{Type: EventPositionQuery, At: start.Add(3 * time.Hour), DistanceKm: 290})
+	log.Close()
+
+	events, err := ReadFile(filepath.Join(dir, "trip.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	locate := func(at time.Time) *models.CurrentPosition {
+		return &models.CurrentPosition{DistanceFromStart: 300}
+	}
+	steps := Replay(testStations(), events, locate)
+
+	want := []struct {
+		typ   EventType
+		delay time.Duration
+		drift float64
+	}{
+		{EventDeparture, 5 * time.Minute, 0},
+		{EventDelay, 15 * time.Minute, 0},
+		{EventArrival, 20 * time.Minute, 0},
+		{EventDeparture, 15 * time.Minute, 0},
+		{EventPositionQuery, 0, 10},
+	}
+	if len(steps) != len(want) {
+		t.Fatalf("%d steps, want %d", len(steps), len(want))
+	}
+	for i, w := range want {
+		if steps[i].Event.Type != w.typ || steps[i].Delay != w.delay || steps[i].DistanceDrift != w.drift {
+			t.Errorf("step %d: %s delay %v drift %v, want %+v", i, steps[i].Event.Type, steps[i].Delay, steps[i].DistanceDrift, w)
+		}
+	}
+
+	report := Compare(testStations(), start, events)
+	if len(report.Segments) != 1 || report.Segments[0].Lost != 15*time.Minute {
+		t.Errorf("A-B must lose 15 minutes: %+v", report.Segments)
+	}
+	if len(report.Days) != 1 || report.Days[0].Arrivals != 1 || report.Days[0].MaxReported != 15*time.Minute {
+		t.Errorf("unexpected day report: %+v", report.Days)
+	}
+}
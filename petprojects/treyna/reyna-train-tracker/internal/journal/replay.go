@@ -0,0 +1,235 @@
+package journal
+
+import (
+	"sort"
+	"time"
+
+	"reyna-train-tracker/internal/models"
+)
+
+// Locator считает позицию поезда по расписанию (обычно TrainTracker.GetCurrentPosition)
+type Locator func(at time.Time) *models.CurrentPosition
+
+// ReplayStep событие журнала и то, что о том же моменте говорит расписание
+type ReplayStep struct {
+	Event     Event
+	Scheduled *models.CurrentPosition // Позиция по расписанию на момент события
+
+	// Для прибытий и отправлений: фактическое время минус плановое
+	Delay time.Duration
+	// Для запросов позиции: насколько пересчитанная позиция отличается от записанной (км)
+	DistanceDrift float64
+}
+
+// Replay заново прогоняет locate по записанной шкале времени (в порядке событий)
+func Replay(stations []models.StationInfo, events []Event, locate Locator) []ReplayStep {
+	ordered := sortedByTime(events)
+	index := indexStations(stations)
+
+	steps := make([]ReplayStep, 0, len(ordered))
+	for _, e := range ordered {
+		step := ReplayStep{Event: e, Scheduled: locate(e.At)}
+
+		switch e.Type {
+		case EventArrival, EventDeparture:
+			if station, ok := index.find(e); ok {
+				step.Delay = e.At.Sub(plannedTime(station, e.Type))
+			}
+		case EventDelay:
+			step.Delay = e.Delay
+		case EventPositionQuery:
+			if step.Scheduled != nil {
+				step.DistanceDrift = step.Scheduled.DistanceFromStart - e.DistanceKm
+			}
+		}
+
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// StationReport плановые и фактические времена на станции
+type StationReport struct {
+	Station         models.StationInfo
+	Day             int // День путешествия по плановому прибытию
+	ActualArrival   time.Time
+	ActualDeparture time.Time
+	ArrivalDelay    time.Duration
+	DepartureDelay  time.Duration
+	HasArrival      bool
+	HasDeparture    bool
+}
+
+// SegmentReport перегон между соседними станциями
+type SegmentReport struct {
+	From, To models.StationInfo
+	Day      int
+	Planned  time.Duration // Плановое время в пути (отправление - прибытие)
+	Actual   time.Duration
+	Lost     time.Duration // Actual - Planned: > 0 - поезд потерял время на перегоне
+}
+
+// DayReport итоги одного дня путешествия
+type DayReport struct {
+	Day            int
+	Arrivals       int           // Станций с фактическим прибытием
+	AvgDelay       time.Duration // Среднее опоздание прибытия
+	MaxDelay       time.Duration
+	Lost           time.Duration // Сумма потерь на перегонах этого дня
+	ReportedDelays int           // Сообщений об опоздании
+	MaxReported    time.Duration
+}
+
+// Report сравнение расписания с фактической поездкой
+type Report struct {
+	Stations []StationReport
+	Segments []SegmentReport
+	Days     []DayReport
+}
+
+// Compare сопоставляет плановые времена станций с событиями журнала.
+// Если по станции несколько событий одного типа, берётся последнее записанное
+func Compare(stations []models.StationInfo, start time.Time, events []Event) Report {
+	index := indexStations(stations)
+
+	byStation := make(map[int]*StationReport)
+	days := make(map[int]*DayReport)
+	dayOf := func(t time.Time) int {
+		return int(t.Sub(start).Hours()/24) + 1
+	}
+	day := func(n int) *DayReport {
+		if days[n] == nil {
+			days[n] = &DayReport{Day: n}
+		}
+		return days[n]
+	}
+
+	for _, e := range sortedBySeq(events) {
+		switch e.Type {
+		case EventArrival, EventDeparture:
+			station, ok := index.find(e)
+			if !ok {
+				continue
+			}
+			r := byStation[station.ID]
+			if r == nil {
+				r = &StationReport{Station: station, Day: dayOf(station.ArrivalTime)}
+				byStation[station.ID] = r
+			}
+			if e.Type == EventArrival {
+				r.ActualArrival, r.HasArrival = e.At, true
+				r.ArrivalDelay = e.At.Sub(station.ArrivalTime)
+			} else {
+				r.ActualDeparture, r.HasDeparture = e.At, true
+				r.DepartureDelay = e.At.Sub(station.DepartureTime)
+			}
+		case EventDelay:
+			d := day(dayOf(e.At))
+			d.ReportedDelays++
+			d.MaxReported = max(d.MaxReported, e.Delay)
+		}
+	}
+
+	var report Report
+	for _, station := range stations {
+		if r, ok := byStation[station.ID]; ok {
+			report.Stations = append(report.Stations, *r)
+		}
+	}
+
+	// Перегоны: от фактического отправления до фактического прибытия на следующую
+	for i := 0; i+1 < len(stations); i++ {
+		from, okFrom := byStation[stations[i].ID]
+		to, okTo := byStation[stations[i+1].ID]
+		if !okFrom || !okTo || !from.HasDeparture || !to.HasArrival {
+			continue
+		}
+
+		planned := stations[i+1].ArrivalTime.Sub(stations[i].DepartureTime)
+		actual := to.ActualArrival.Sub(from.ActualDeparture)
+		segment := SegmentReport{
+			From:    stations[i],
+			To:      stations[i+1],
+			Day:     dayOf(stations[i].DepartureTime),
+			Planned: planned,
+			Actual:  actual,
+			Lost:    actual - planned,
+		}
+		report.Segments = append(report.Segments, segment)
+		day(segment.Day).Lost += segment.Lost
+	}
+
+	sums := make(map[int]time.Duration)
+	for _, r := range report.Stations {
+		if !r.HasArrival {
+			continue
+		}
+		d := day(r.Day)
+		d.Arrivals++
+		d.MaxDelay = max(d.MaxDelay, r.ArrivalDelay)
+		sums[r.Day] += r.ArrivalDelay
+	}
+
+	for n, d := range days {
+		if d.Arrivals > 0 {
+			d.AvgDelay = sums[n] / time.Duration(d.Arrivals)
+		}
+		report.Days = append(report.Days, *d)
+	}
+	sort.Slice(report.Days, func(i, j int) bool {
+		return report.Days[i].Day < report.Days[j].Day
+	})
+
+	return report
+}
+
+// plannedTime плановое время события на станции
+func plannedTime(station models.StationInfo, t EventType) time.Time {
+	if t == EventDeparture {
+		return station.DepartureTime
+	}
+	return station.ArrivalTime
+}
+
+// stationIndex поиск станции события по ID или названию
+type stationIndex struct {
+	byID   map[int]models.StationInfo
+	byName map[string]models.StationInfo
+}
+
+func indexStations(stations []models.StationInfo) stationIndex {
+	index := stationIndex{
+		byID:   make(map[int]models.StationInfo, len(stations)),
+		byName: make(map[string]models.StationInfo, len(stations)),
+	}
+	for _, station := range stations {
+		index.byID[station.ID] = station
+		index.byName[station.Name] = station
+	}
+	return index
+}
+
+func (i stationIndex) find(e Event) (models.StationInfo, bool) {
+	if e.StationID != 0 {
+		station, ok := i.byID[e.StationID]
+		return station, ok
+	}
+	station, ok := i.byName[e.Station]
+	return station, ok
+}
+
+func sortedByTime(events []Event) []Event {
+	ordered := append([]Event(nil), events...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].At.Before(ordered[j].At)
+	})
+	return ordered
+}
+
+func sortedBySeq(events []Event) []Event {
+	ordered := append([]Event(nil), events...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Seq < ordered[j].Seq
+	})
+	return ordered
+}
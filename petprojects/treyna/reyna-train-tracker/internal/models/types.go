@@ -52,9 +52,93 @@ type TrainStatus struct {
 
 // JourneyInfo информация о путешествии
 type JourneyInfo struct {
-	DayNumber        int           // Какой день путешествия
+	DayNumber        int           // Какой день путешествия (по 24 часа от отправления)
+	CalendarDay      int           // Какой день путешествия по местному календарю пассажира
+	LocalDate        time.Time     // Местная дата пассажира (полночь)
+	Timezone         string        // Часовой пояс пассажира
 	StartDate        time.Time     // Дата начала путешествия
 	TotalTimeInTrip  time.Duration // Общее время в пути
+	DateChanges      []DateChange  // Смены местной даты впереди (до прибытия)
+}
+
+// TimezoneBorder граница часовых поясов на перегоне
+type TimezoneBorder struct {
+	Km          float64   // Километр границы от Москвы
+	From        string    // Пояс до границы
+	To          string    // Пояс после границы
+	PrevStation string    // Станция перед границей
+	NextStation string    // Станция после границы
+	ETA         time.Time // Когда поезд пересекает границу по расписанию
+	Estimated   bool      // Граница оценена по середине перегона, а не взята из таблицы
+}
+
+// TimezoneChange предстоящая смена часового пояса у пассажира
+type TimezoneChange struct {
+	Border     TimezoneBorder
+	DistanceKm float64       // Сколько км осталось до границы
+	In         time.Duration // Через сколько поезд её пересечёт
+	NewOffset  time.Duration // Смещение от UTC после границы
+	Shift      time.Duration // На сколько переведут часы
+}
+
+// SegmentSpeed скорость на перегоне по расписанию и по наблюдениям
+type SegmentSpeed struct {
+	From          string
+	To            string
+	DistanceKm    int
+	PlannedSpeed  float64 // км/ч по расписанию
+	ObservedSpeed float64 // км/ч по журналу поездки (0 - перегон не наблюдался)
+}
+
+// ArrivalPrediction прогноз прибытия на станцию с интервалом
+type ArrivalPrediction struct {
+	Station      string
+	Scheduled    time.Time     // Время прибытия по расписанию
+	Expected     time.Time     // Прогноз
+	Earliest     time.Time     // Нижняя граница интервала
+	Latest       time.Time     // Верхняя граница интервала
+	Delay        time.Duration // Expected - Scheduled
+	Confidence   float64       // Доля прибытий, попадающих в интервал (0.8 - 80%)
+	Observations int           // Сколько наблюдений журнала учтено
+}
+
+// DateChange смена местной даты у пассажира
+type DateChange struct {
+	At         time.Time     // Момент смены (абсолютное время)
+	In         time.Duration // Через сколько от текущего момента
+	NewDate    time.Time     // Наступившая местная дата (полночь)
+	Timezone   string        // Часовой пояс, по которому сменилась дата
+	Station    string        // Последняя станция перед сменой даты
+	ClockShift bool          // Дата сменилась при переводе часов, а не в полночь
+}
+
+// MilestoneKind вид события поездки по расписанию
+type MilestoneKind string
+
+const (
+	MilestoneArrival   MilestoneKind = "arrival"   // Прибытие на станцию
+	MilestoneDeparture MilestoneKind = "departure" // Отправление со станции
+	MilestoneTimezone  MilestoneKind = "timezone"  // Пересечение границы часовых поясов
+	MilestoneDate      MilestoneKind = "date"      // Смена местной даты
+)
+
+// Milestone событие поездки по расписанию: то, что пассажир заметит в пути
+type Milestone struct {
+	At       time.Time // Момент события (абсолютное время)
+	Kind     MilestoneKind
+	Station  string        // Станция; для границы и смены даты - последняя станция перед ними
+	Timezone string        // Пояс пассажира после события
+	Shift    time.Duration // Перевод часов на границе (0 - пояс сменился без перевода)
+	Date     time.Time     // Наступившая местная дата (для MilestoneDate)
+}
+
+// CallWindow окно для звонка: и в Москве, и у пассажира не ночь
+type CallWindow struct {
+	Start    time.Time     // Начало окна (абсолютное время)
+	End      time.Time     // Конец окна
+	Duration time.Duration // Длина окна
+	Timezone string        // Пояс пассажира в начале окна
+	Stops    []string      // Стоянки в окне: на станции связь обычно лучше
 }
 
 // MessageDelivery информация о доставке сообщений
@@ -69,6 +153,12 @@ type CacheEntry[T any] struct {
 	Value     T
 	Timestamp time.Time
 	TTL       time.Duration
+	Size      int // Оценочный размер записи (для ограниченного кэша)
+}
+
+// Expired проверяет, истёк ли TTL записи к моменту now
+func (e CacheEntry[T]) Expired(now time.Time) bool {
+	return e.TTL > 0 && now.Sub(e.Timestamp) > e.TTL
 }
 
 // QuestionResult результат ответа на вопрос
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"reyna-train-tracker/internal/gql"
+)
+
+// maxGraphQLBody предел тела GraphQL-запроса
+const maxGraphQLBody = 64 << 10
+
+// handleGraphQL выполняет GraphQL-запрос: POST с JSON {query, variables, operationName}
+// или GET с ?query= (и ?variables= в JSON). Ошибки запроса возвращаются
+// в поле errors со статусом 200, как принято в GraphQL
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req gql.Request
+	if r.Method == http.MethodGet {
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if raw := r.URL.Query().Get("variables"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &req.Variables); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid 'variables' parameter, expected JSON object")
+				return
+			}
+		}
+	} else if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLBody)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if req.Query == "" {
+		writeError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	lang := s.requestLang(r)
+	w.Header().Set("Content-Language", string(lang))
+	writeJSON(w, http.StatusOK, s.GraphQL.Execute(r.Context(), req, lang))
+}
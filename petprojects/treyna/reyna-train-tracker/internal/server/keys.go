@@ -0,0 +1,107 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"reyna-train-tracker/internal/auth"
+)
+
+// require пропускает запрос к next только с ключом, у которого есть право scope,
+// и учитывает его в лимитах ключа. Без проверки ключей (Auth == nil) - next как есть
+func (s *Server) require(scope auth.Scope, next http.HandlerFunc) http.HandlerFunc {
+	if s.Auth == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, err := s.Auth.Authorize(auth.RequestToken(r), scope)
+		var limit *auth.LimitError
+		switch {
+		case err == nil:
+			next(w, r)
+		case errors.Is(err, auth.ErrUnauthorized):
+			w.Header().Set("WWW-Authenticate", `Bearer realm="reyna-train-tracker"`)
+			writeError(w, http.StatusUnauthorized, err.Error())
+		case errors.Is(err, auth.ErrForbidden):
+			writeError(w, http.StatusForbidden, err.Error())
+		case errors.As(err, &limit):
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(limit.RetryAfter.Seconds()))))
+			writeError(w, http.StatusTooManyRequests, err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, err.Error())
+		}
+	}
+}
+
+// createKeyRequest тело POST /api/keys
+type createKeyRequest struct {
+	Name          string       `json:"name"`
+	Scopes        []auth.Scope `json:"scopes"`
+	RatePerMinute int          `json:"rate_per_minute"` // 0 - по умолчанию
+	DailyQuota    int          `json:"daily_quota"`     // 0 - по умолчанию
+}
+
+// keyView ключ без хеша и его учёт
+type keyView struct {
+	auth.Key
+	Usage auth.Usage `json:"usage"`
+}
+
+// handleKeys список ключей с учётом запросов
+func (s *Server) handleKeys(w http.ResponseWriter, r *http.Request) {
+	if s.Auth == nil {
+		writeError(w, http.StatusNotFound, "api keys are disabled")
+		return
+	}
+	keys := s.Auth.Store.Keys()
+	views := make([]keyView, 0, len(keys))
+	for _, key := range keys {
+		views = append(views, keyView{Key: key.Public(), Usage: s.Auth.Usage(key)})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"scopes": auth.Scopes(),
+		"keys":   views,
+	})
+}
+
+// handleCreateKey создаёт ключ. Сам ключ возвращается только в этом ответе
+func (s *Server) handleCreateKey(w http.ResponseWriter, r *http.Request) {
+	if s.Auth == nil {
+		writeError(w, http.StatusNotFound, "api keys are disabled")
+		return
+	}
+	var req createKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	key, token, err := s.Auth.CreateKey(req.Name, req.Scopes, req.RatePerMinute, req.DailyQuota)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"key":   key.Public(),
+		"token": token,
+	})
+}
+
+func (s *Server) handleRevokeKey(w http.ResponseWriter, r *http.Request) {
+	if s.Auth == nil {
+		writeError(w, http.StatusNotFound, "api keys are disabled")
+		return
+	}
+	err := s.Auth.RevokeKey(r.PathValue("id"))
+	switch {
+	case errors.Is(err, auth.ErrKeyNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,327 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/auth"
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/gql"
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/webhook"
+)
+
+// Server HTTP сервер с JSON API поверх трекера и обработчика вопросов
+type Server struct {
+	Tracker  *tracker.TrainTracker
+	Handler  *api.QuestionHandler
+	Webhooks *webhook.Dispatcher
+	GraphQL  *gql.Executor
+	Auth     *auth.Authenticator // nil - API открыт без ключей
+
+	httpServer *http.Server
+}
+
+// NewServer создаёт сервер и регистрирует маршруты.
+// С keys каждый маршрут API требует ключ с нужным правом; статика веб-интерфейса открыта
+func NewServer(cfg *config.Config, t *tracker.TrainTracker, h *api.QuestionHandler, hooks *webhook.Dispatcher, keys *auth.Authenticator) *Server {
+	s := &Server{
+		Tracker:  t,
+		Handler:  h,
+		Webhooks: hooks,
+		GraphQL:  gql.NewExecutor(t, h),
+		Auth:     keys,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/position", s.require(auth.ScopePosition, s.handlePosition))
+	mux.HandleFunc("GET /api/status", s.require(auth.ScopePosition, s.handleStatus))
+	mux.HandleFunc("GET /api/journey", s.require(auth.ScopePosition, s.handleJourney))
+	mux.HandleFunc("GET /api/timezones", s.require(auth.ScopePosition, s.handleTimezones))
+	mux.HandleFunc("GET /api/questions", s.require(auth.ScopePosition, s.handleQuestions))
+	mux.HandleFunc("GET /api/stats", s.require(auth.ScopeAdmin, s.handleStats))
+	mux.HandleFunc("GET /api/workers", s.require(auth.ScopeAdmin, s.handleWorkers))
+	mux.HandleFunc("POST /api/workers/{id}/{action}", s.require(auth.ScopeAdmin, s.handleWorkerAction))
+	mux.HandleFunc("GET /api/events", s.require(auth.ScopePosition, s.handleEvents))
+	mux.HandleFunc("POST /api/events", s.require(auth.ScopeAdmin, s.handleRecordEvent))
+	mux.HandleFunc("GET /api/clock", s.require(auth.ScopePosition, s.handleClock))
+	mux.HandleFunc("GET /api/timetable", s.require(auth.ScopeTimetable, s.handleTimetable))
+	mux.HandleFunc("GET /api/calls", s.require(auth.ScopePosition, s.handleCalls))
+	mux.HandleFunc("POST /api/webhooks", s.require(auth.ScopeAdmin, s.handleSubscribe))
+	mux.HandleFunc("GET /api/webhooks", s.require(auth.ScopeAdmin, s.handleSubscriptions))
+	mux.HandleFunc("DELETE /api/webhooks/{id}", s.require(auth.ScopeAdmin, s.handleUnsubscribe))
+	mux.HandleFunc("GET /api/webhooks/dead-letters", s.require(auth.ScopeAdmin, s.handleDeadLetters))
+	mux.HandleFunc("POST /api/webhooks/dead-letters/{id}/replay", s.require(auth.ScopeAdmin, s.handleReplay))
+	mux.HandleFunc("GET /api/graphql", s.require(auth.ScopePosition, s.handleGraphQL))
+	mux.HandleFunc("POST /api/graphql", s.require(auth.ScopePosition, s.handleGraphQL))
+	mux.HandleFunc("GET /api/keys", s.require(auth.ScopeAdmin, s.handleKeys))
+	mux.HandleFunc("POST /api/keys", s.require(auth.ScopeAdmin, s.handleCreateKey))
+	mux.HandleFunc("DELETE /api/keys/{id}", s.require(auth.ScopeAdmin, s.handleRevokeKey))
+	mux.Handle("GET /", webHandler())
+
+	s.httpServer = &http.Server{
+		Addr:              ":" + cfg.ServerPort,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	return s
+}
+
+// Routes возвращает корневой http.Handler сервера
+func (s *Server) Routes() http.Handler {
+	return s.httpServer.Handler
+}
+
+// ListenAndServe запускает сервер. После Shutdown возвращает nil
+func (s *Server) ListenAndServe() error {
+	err := s.httpServer.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Shutdown корректно останавливает сервер:
+// перестаёт принимать соединения, дожидается текущих запросов
+// (в том числе пакетов вопросов), затем останавливает вебхуки, обработчик, лимитеры ключей и трекер
+func (s *Server) Shutdown(ctx context.Context) error {
+	httpErr := s.httpServer.Shutdown(ctx)
+	hooksErr := s.Webhooks.Close(ctx)
+	handlerErr := s.Handler.Shutdown(ctx)
+	if s.Auth != nil {
+		s.Auth.Close()
+	}
+	s.Tracker.Close()
+
+	return errors.Join(httpErr, hooksErr, handlerErr)
+}
+
+func (s *Server) handlePosition(w http.ResponseWriter, r *http.Request) {
+	at, ok := s.queryTime(w, r)
+	if !ok {
+		return
+	}
+
+	pos := s.Tracker.GetCurrentPosition(at)
+	if pos == nil {
+		writeError(w, http.StatusNotFound, "position not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, pos)
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	at, ok := s.queryTime(w, r)
+	if !ok {
+		return
+	}
+
+	pos := s.Tracker.GetCurrentPosition(at)
+	writeJSON(w, http.StatusOK, s.Tracker.GetTrainStatus(at, pos))
+}
+
+func (s *Server) handleJourney(w http.ResponseWriter, r *http.Request) {
+	at, ok := s.queryTime(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.Tracker.GetJourneyInfo(at))
+}
+
+// handleTimezones границы поясов на маршруте и предстоящие переводы часов
+func (s *Server) handleTimezones(w http.ResponseWriter, r *http.Request) {
+	at, ok := s.queryTime(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"timezone": s.Tracker.TimezoneAt(at),
+		"borders":  s.Tracker.TimezoneBorders(),
+		"upcoming": s.Tracker.UpcomingTimezoneChanges(at),
+	})
+}
+
+func (s *Server) handleQuestions(w http.ResponseWriter, r *http.Request) {
+	at, ok := s.queryTime(w, r)
+	if !ok {
+		return
+	}
+
+	lang := s.requestLang(r)
+	results := s.Handler.ProcessAllQuestionsIn(at, lang)
+	if results == nil {
+		writeError(w, http.StatusServiceUnavailable, "server is shutting down")
+		return
+	}
+	w.Header().Set("Content-Language", string(lang))
+	writeJSON(w, http.StatusOK, results)
+}
+
+// requestLang выбирает язык ответа: параметр ?lang=, затем Accept-Language,
+// затем язык обработчика по умолчанию
+func (s *Server) requestLang(r *http.Request) i18n.Lang {
+	if lang, ok := i18n.Parse(r.URL.Query().Get("lang")); ok {
+		return lang
+	}
+	if header := r.Header.Get("Accept-Language"); header != "" {
+		return i18n.FromAcceptLanguage(header)
+	}
+	return s.Handler.Language
+}
+
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	stats := s.Tracker.GetStatistics()
+	stats["workers"] = s.Handler.LoadBalancer.GetWorkerStats()
+	stats["rate_limiter_tokens"] = s.Handler.RateLimiter.GetTokenCount()
+	stats["queue"] = s.Handler.Pool.GetQueueStats()
+	stats["webhooks"] = s.Webhooks.Stats()
+	if s.Handler.Metrics != nil {
+		stats["metrics"] = s.Handler.Metrics.GetMetrics()
+	}
+	writeJSON(w, http.StatusOK, stats)
+}
+
+func (s *Server) handleWorkers(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"strategy": s.Handler.LoadBalancer.Strategy(),
+		"workers":  s.Handler.LoadBalancer.GetWorkerStats(),
+	})
+}
+
+// handleWorkerAction включает, выключает или выводит из работы воркера
+func (s *Server) handleWorkerAction(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid worker id")
+		return
+	}
+
+	lb := s.Handler.LoadBalancer
+	switch r.PathValue("action") {
+	case "activate":
+		err = lb.Activate(id)
+	case "deactivate":
+		err = lb.Deactivate(id)
+	case "drain":
+		err = lb.Drain(r.Context(), id)
+	default:
+		writeError(w, http.StatusNotFound, "unknown action")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	}
+
+	s.handleWorkers(w, r)
+}
+
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if s.Tracker.Journal == nil {
+		writeError(w, http.StatusNotFound, tracker.ErrJournalDisabled.Error())
+		return
+	}
+
+	events, err := s.Tracker.Journal.Events()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"trip_id": s.Tracker.TripID,
+		"events":  events,
+	})
+}
+
+// eventRequest тело POST /api/events
+type eventRequest struct {
+	Type      journal.EventType `json:"type"`
+	At        string            `json:"at"` // RFC3339, по умолчанию - сейчас
+	StationID int               `json:"station_id"`
+	Station   string            `json:"station"`
+	Delay     string            `json:"delay"` // Длительность: "25m", "1h10m"
+	Note      string            `json:"note"`
+}
+
+// handleRecordEvent записывает наблюдение: прибытие, отправление или опоздание
+func (s *Server) handleRecordEvent(w http.ResponseWriter, r *http.Request) {
+	var req eventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	e := journal.Event{
+		Type:      req.Type,
+		At:        s.Tracker.Now(),
+		StationID: req.StationID,
+		Station:   req.Station,
+		Note:      req.Note,
+	}
+	if req.At != "" {
+		at, err := time.Parse(time.RFC3339, req.At)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid 'at' field, expected RFC3339")
+			return
+		}
+		e.At = at
+	}
+	if req.Delay != "" {
+		delay, err := time.ParseDuration(req.Delay)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid 'delay' field, expected duration like 25m")
+			return
+		}
+		e.Delay = delay
+	}
+
+	recorded, err := s.Tracker.RecordEvent(e)
+	switch {
+	case errors.Is(err, tracker.ErrJournalDisabled):
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	case err != nil:
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if e, ok := webhook.FromJournal(recorded, s.Tracker.TripID); ok {
+		s.Webhooks.Publish(e)
+	}
+	writeJSON(w, http.StatusCreated, recorded)
+}
+
+// queryTime читает момент времени из параметра ?at= (RFC3339).
+// Без параметра используется текущее время по часам трекера
+func (s *Server) queryTime(w http.ResponseWriter, r *http.Request) (time.Time, bool) {
+	raw := r.URL.Query().Get("at")
+	if raw == "" {
+		return s.Tracker.Now(), true
+	}
+
+	at, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid 'at' parameter, expected RFC3339")
+		return time.Time{}, false
+	}
+	return at, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{"error": message})
+}
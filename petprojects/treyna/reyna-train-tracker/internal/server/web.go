@@ -0,0 +1,95 @@
+package server
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"time"
+
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/timeline"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// webFiles одностраничный интерфейс для семьи: карта маршрута, расписание,
+// часы и окна для звонков. Встроен в бинарник, данные берёт из JSON API
+//
+//go:embed web
+var webFiles embed.FS
+
+// Ограничения планировщика звонков
+const (
+	defaultCallHours = 24
+	maxCallHours     = 7 * 24
+)
+
+// webHandler раздаёт встроенный интерфейс с корня сайта
+func webHandler() http.Handler {
+	root, err := fs.Sub(webFiles, "web")
+	if err != nil {
+		// Каталог встроен при сборке: ошибка возможна только при опечатке в пути
+		panic(err)
+	}
+	return http.FileServerFS(root)
+}
+
+// handleClock текущий момент по часам трекера: интерфейс идёт от него,
+// а не от часов браузера (в симуляции они расходятся)
+func (s *Server) handleClock(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"now":      s.Tracker.Now(),
+		"timezone": s.Tracker.TimezoneAt(s.Tracker.Now()),
+	})
+}
+
+// handleTimetable полное расписание с московским и местным временем
+func (s *Server) handleTimetable(w http.ResponseWriter, r *http.Request) {
+	lang := s.requestLang(r)
+	w.Header().Set("Content-Language", string(lang))
+	writeJSON(w, http.StatusOK, timeline.Build(s.Tracker.RouteData, s.Tracker.Stations, lang))
+}
+
+// handleCalls окна для звонков: ?at= начало, ?hours= длина интервала,
+// ?from= и ?until= часы, когда удобно звонить (по умолчанию 9 и 22)
+func (s *Server) handleCalls(w http.ResponseWriter, r *http.Request) {
+	at, ok := s.queryTime(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	hours, okHours := queryInt(query.Get("hours"), defaultCallHours, 1, maxCallHours)
+	awakeFrom, okFrom := queryInt(query.Get("from"), tracker.DefaultAwakeFrom, 0, 23)
+	awakeUntil, okUntil := queryInt(query.Get("until"), tracker.DefaultAwakeUntil, 1, 24)
+	if !okHours || !okFrom || !okUntil || awakeFrom >= awakeUntil {
+		writeError(w, http.StatusBadRequest, "invalid 'hours', 'from' or 'until' parameter")
+		return
+	}
+
+	lang := s.requestLang(r)
+	windows := s.Tracker.CallWindows(at, at.Add(time.Duration(hours)*time.Hour), awakeFrom, awakeUntil)
+	for i := range windows {
+		for j, stop := range windows[i].Stops {
+			windows[i].Stops[j] = i18n.StationName(lang, stop)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"from":    at,
+		"hours":   hours,
+		"awake":   []int{awakeFrom, awakeUntil},
+		"windows": windows,
+	})
+}
+
+// queryInt целый параметр в пределах [lo, hi]; пустой - значение по умолчанию
+func queryInt(raw string, def, lo, hi int) (int, bool) {
+	if raw == "" {
+		return def, true
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < lo || n > hi {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,279 @@
+// Интерфейс трекера: берёт данные только из JSON API этого же сервера
+"use strict";
+
+const strings = {
+  ru: {
+    title: "🚂 Трекер Рэйны", map: "Маршрут", local: "У Рэйны", moscow: "В Москве",
+    next_clock_change: "Перевод часов", calls: "Когда удобно звонить",
+    calls_hint: "Окна, когда и в Москве, и у Рэйны с 9:00 до 22:00",
+    timetable: "Расписание", at_station: "Стоит на станции {0}, до отправления {1}",
+    moving: "В пути: {0} → {1}, до прибытия {2}", unknown: "Поезд сейчас не в пути",
+    progress: "Пройдено {0} из {1} км ({2}%)", no_change: "до конца маршрута не будет",
+    clock_change: "{0} через {1}", no_calls: "В ближайшие сутки удобных окон нет",
+    call_now: "сейчас", stops: "стоянки: {0}", updated: "Обновлено {0}", error: "Нет связи с сервером",
+    min: "мин", h: "ч",
+  },
+  en: {
+    title: "🚂 Reyna's tracker", map: "Route", local: "Reyna's time", moscow: "Moscow",
+    next_clock_change: "Clock change", calls: "Good times to call",
+    calls_hint: "Windows when it's 9:00-22:00 both in Moscow and for Reyna",
+    timetable: "Timetable", at_station: "Stopped at {0}, departs in {1}",
+    moving: "On the way: {0} → {1}, arrives in {2}", unknown: "The train is not on the way now",
+    progress: "{0} of {1} km done ({2}%)", no_change: "none until the end of the route",
+    clock_change: "{0} in {1}", no_calls: "No good windows in the next 24 hours",
+    call_now: "now", stops: "stops: {0}", updated: "Updated {0}", error: "No connection to the server",
+    min: "min", h: "h",
+  },
+};
+
+const lang = (() => {
+  const requested = new URLSearchParams(location.search).get("lang") || navigator.language || "ru";
+  return requested.toLowerCase().startsWith("en") ? "en" : "ru";
+})();
+
+const NS = 1e6; // Длительности в JSON - наносекунды
+const REFRESH_MS = 10000;
+
+const state = {
+  offset: 0,        // Часы трекера минус часы браузера
+  timezone: "Europe/Moscow",
+  timetable: null,
+  borders: [],
+  distance: 0,
+};
+
+function t(key, ...args) {
+  const template = strings[lang][key] || key;
+  return template.replace(/\{(\d+)\}/g, (_, i) => args[i]);
+}
+
+// Ключ API (если сервер их требует): из ссылки вида /#key=rtk_..., дальше - из localStorage
+const apiKey = (() => {
+  const fromLink = new URLSearchParams(location.hash.slice(1)).get("key");
+  if (fromLink) {
+    localStorage.setItem("apiKey", fromLink);
+    history.replaceState(null, "", location.pathname + location.search);
+  }
+  return localStorage.getItem("apiKey");
+})();
+
+async function api(path, params = {}) {
+  const query = new URLSearchParams({ lang, ...params });
+  const headers = apiKey ? { "X-API-Key": apiKey } : {};
+  const response = await fetch(`/api/${path}?${query}`, { headers });
+  if (!response.ok) {
+    throw Object.assign(new Error(`${path}: ${response.status}`), { status: response.status });
+  }
+  return response.json();
+}
+
+function trackerNow() {
+  return new Date(Date.now() + state.offset);
+}
+
+function formatTime(date, timeZone, withSeconds = false) {
+  return new Intl.DateTimeFormat(lang, {
+    timeZone, hour: "2-digit", minute: "2-digit", second: withSeconds ? "2-digit" : undefined,
+    day: withSeconds ? undefined : "2-digit", month: withSeconds ? undefined : "2-digit",
+  }).format(date);
+}
+
+function formatDuration(ns) {
+  const minutes = Math.max(0, Math.round(ns / NS / 60000));
+  const h = Math.floor(minutes / 60);
+  return h > 0 ? `${h} ${t("h")} ${minutes % 60} ${t("min")}` : `${minutes} ${t("min")}`;
+}
+
+function el(id) {
+  return document.getElementById(id);
+}
+
+function translateStatic() {
+  document.documentElement.lang = lang;
+  document.title = t("title");
+  el("title").textContent = t("title");
+  document.querySelectorAll("[data-i18n]").forEach((node) => {
+    node.textContent = t(node.dataset.i18n);
+  });
+}
+
+function tick() {
+  const now = trackerNow();
+  el("clock-local").textContent = formatTime(now, state.timezone, true);
+  el("clock-local-tz").textContent = state.timezone;
+  el("clock-moscow").textContent = formatTime(now, "Europe/Moscow", true);
+}
+
+function renderState(position, status) {
+  let text = t("unknown");
+  if (position && position.IsAtStation && position.CurrentStation) {
+    text = t("at_station", position.CurrentStation.Name, formatDuration(status.RemainingStand));
+  } else if (position && position.PreviousStation && position.NextStation) {
+    text = t("moving", position.PreviousStation.Name, position.NextStation.Name, formatDuration(status.TimeToNext));
+  }
+  el("state").textContent = text;
+}
+
+function svg(name, attrs = {}, text) {
+  const node = document.createElementNS("http://www.w3.org/2000/svg", name);
+  Object.entries(attrs).forEach(([key, value]) => node.setAttribute(key, value));
+  if (text !== undefined) node.textContent = text;
+  return node;
+}
+
+// renderMap схема маршрута по километрам: станции, границы поясов и поезд
+function renderMap() {
+  const map = el("map");
+  const rows = state.timetable ? state.timetable.Rows : [];
+  if (rows.length === 0) return;
+
+  const total = rows[rows.length - 1].Km || 1;
+  const x = (km) => 20 + (Math.min(Math.max(km, 0), total) / total) * 960;
+  const y = 110;
+
+  map.replaceChildren();
+  map.append(svg("line", { class: "line", x1: x(0), y1: y, x2: x(total), y2: y }));
+  map.append(svg("line", { class: "done", x1: x(0), y1: y, x2: x(state.distance), y2: y }));
+
+  state.borders.forEach((border) => {
+    map.append(svg("line", { class: "border", x1: x(border.Km), y1: 20, x2: x(border.Km), y2: 150 }));
+    map.append(svg("text", { class: "border-label", x: x(border.Km) + 3, y: 160 }, border.To.split("/").pop()));
+  });
+
+  rows.forEach((row) => {
+    map.append(svg("circle", { class: row.Major ? "station major" : "station", cx: x(row.Km), cy: y, r: row.Major ? 5 : 2.5 }));
+    if (row.Major) {
+      const lx = x(row.Km);
+      map.append(svg("text", { class: "label", x: lx, y: y - 12, transform: `rotate(-40 ${lx} ${y - 12})` }, row.Station));
+    }
+  });
+
+  map.append(svg("text", { class: "train", x: x(state.distance) - 11, y: y + 30 }, "🚂"));
+  el("progress").textContent = t("progress", Math.round(state.distance), total, ((state.distance / total) * 100).toFixed(1));
+}
+
+function renderTimetable(position) {
+  const table = el("timetable");
+  if (!state.timetable) return;
+
+  const head = document.createElement("tr");
+  state.timetable.Columns.forEach((column) => {
+    const th = document.createElement("th");
+    th.textContent = column;
+    head.append(th);
+  });
+  table.tHead.replaceChildren(head);
+
+  const currentKm = position && position.IsAtStation && position.CurrentStation
+    ? position.CurrentStation.DistanceFromStart : -1;
+  const body = state.timetable.Rows.map((row) => {
+    const tr = document.createElement("tr");
+    if (row.Major) tr.classList.add("major");
+    if (row.Km === currentKm) tr.classList.add("current");
+    else if (row.Km < state.distance) tr.classList.add("passed");
+
+    [row.Number, (row.Major ? "★ " : "") + row.Station, row.Km, row.Day, row.Arrival, row.Departure,
+      row.LocalArrival, row.LocalDeparture, row.Stand, row.Timezone].forEach((value) => {
+      const td = document.createElement("td");
+      td.textContent = value;
+      tr.append(td);
+    });
+    return tr;
+  });
+  table.tBodies[0].replaceChildren(...body);
+}
+
+function renderClockChange(timezones) {
+  const next = (timezones.upcoming || [])[0];
+  if (!next) {
+    el("clock-change").textContent = "—";
+    el("clock-change-info").textContent = t("no_change");
+    return;
+  }
+  const hours = next.Shift / NS / 3600000;
+  el("clock-change").textContent = t("clock_change", (hours > 0 ? "+" : "") + hours + " " + t("h"), formatDuration(next.In));
+  el("clock-change-info").textContent = `${next.Border.PrevStation} → ${next.Border.NextStation}, ${next.Border.To}`;
+}
+
+function renderCalls(calls) {
+  const list = el("calls");
+  const now = trackerNow();
+  const items = (calls.windows || []).map((w) => {
+    const li = document.createElement("li");
+    const start = new Date(w.Start);
+    const end = new Date(w.End);
+    const moscow = `${formatTime(start, "Europe/Moscow")} – ${formatTime(end, "Europe/Moscow")} MSK`;
+    const local = `${formatTime(start, w.Timezone)} – ${formatTime(end, w.Timezone)} ${w.Timezone}`;
+    li.textContent = `${moscow} · ${local}`;
+    if (w.Stops && w.Stops.length > 0) {
+      li.textContent += ` · ${t("stops", w.Stops.join(", "))}`;
+    }
+    if (start <= now && now < end) {
+      const mark = document.createElement("span");
+      mark.className = "now";
+      mark.textContent = ` (${t("call_now")})`;
+      li.append(mark);
+    }
+    return li;
+  });
+  if (items.length === 0) {
+    const li = document.createElement("li");
+    li.textContent = t("no_calls");
+    items.push(li);
+  }
+  list.replaceChildren(...items);
+}
+
+// optional запрос, у которого 404 - нормальный ответ (поезд не в пути)
+async function optional(promise) {
+  try {
+    return await promise;
+  } catch (err) {
+    if (err.status === 404) return null;
+    throw err;
+  }
+}
+
+async function refresh() {
+  try {
+    const clock = await api("clock");
+    state.offset = new Date(clock.now).getTime() - Date.now();
+    state.timezone = clock.timezone || state.timezone;
+
+    const at = clock.now;
+    const [position, status, journey, timezones, calls] = await Promise.all([
+      optional(api("position", { at })),
+      api("status", { at }),
+      api("journey", { at }),
+      api("timezones", { at }),
+      api("calls", { at }),
+    ]);
+    if (!state.timetable) {
+      state.timetable = await api("timetable");
+    }
+
+    state.borders = timezones.borders || [];
+    // Позиции нет только вне поездки: до отправления или после прибытия
+    const rows = state.timetable.Rows;
+    if (position) {
+      state.distance = position.DistanceFromStart;
+    } else {
+      state.distance = journey.TotalTimeInTrip > 0 && rows.length > 0 ? rows[rows.length - 1].Km : 0;
+    }
+
+    renderState(position, status);
+    renderMap();
+    renderClockChange(timezones);
+    renderCalls(calls);
+    renderTimetable(position);
+    tick();
+    el("updated").textContent = t("updated", formatTime(new Date(), Intl.DateTimeFormat().resolvedOptions().timeZone, true));
+  } catch (err) {
+    el("updated").textContent = `${t("error")}: ${err.message}`;
+  }
+}
+
+translateStatic();
+refresh();
+setInterval(refresh, REFRESH_MS);
+setInterval(tick, 1000);
@@ -0,0 +1,186 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/auth"
+	"reyna-train-tracker/internal/server"
+	"reyna-train-tracker/internal/timeline"
+	"reyna-train-tracker/internal/tracker/trackertest"
+	"reyna-train-tracker/internal/webhook"
+)
+
+var departureDay = time.Date(2025, 10, 7, 9, 0, 0, 0, time.FixedZone("MSK", 3*3600))
+
+// newServer сервер поверх тестового трекера; keys nil - API без ключей
+func newServer(t *testing.T, keys *auth.Authenticator) http.Handler {
+	t.Helper()
+
+	trainTracker, handler := trackertest.New(t, departureDay)
+	store, err := webhook.NewStore(filepath.Join(t.TempDir(), "webhooks.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hooks := webhook.NewDispatcher(store, webhook.Options{})
+	t.Cleanup(func() { hooks.Close(context.Background()) })
+
+	return server.NewServer(trackertest.Config(), trainTracker, handler, hooks, keys).Routes()
+}
+
+func get(t *testing.T, h http.Handler, target string, header ...string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestWebUIFiles(t *testing.T) {
+	h := newServer(t, nil)
+
+	tests := []struct {
+		path        string
+		status      int
+		contentType string
+		body        string
+	}{
+		{"/", http.StatusOK, "text/html", "app.js"},
+		{"/index.html", http.StatusMovedPermanently, "", ""},
+		{"/app.js", http.StatusOK, "javascript", "/api/"},
+		{"/style.css", http.StatusOK, "text/css", ""},
+		{"/missing.js", http.StatusNotFound, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			w := get(t, h, tt.path)
+			if w.Code != tt.status {
+				t.Fatalf("status %d, want %d", w.Code, tt.status)
+			}
+			if !strings.Contains(w.Header().Get("Content-Type"), tt.contentType) {
+				t.Errorf("content type %q, want %q", w.Header().Get("Content-Type"), tt.contentType)
+			}
+			if !strings.Contains(w.Body.String(), tt.body) {
+				t.Errorf("body has no %q", tt.body)
+			}
+		})
+	}
+}
+
+func TestWebUIOpenWithAuth(t *testing.T) {
+	store, err := auth.NewStore(filepath.Join(t.TempDir(), "keys.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := auth.NewAuthenticator(store, auth.Limits{}, nil)
+	h := newServer(t, keys)
+
+	if w := get(t, h, "/"); w.Code != http.StatusOK {
+		t.Errorf("web UI must stay open, got %d", w.Code)
+	}
+	for _, path := range []string{"/api/clock", "/api/timetable", "/api/calls"} {
+		if w := get(t, h, path); w.Code != http.StatusUnauthorized {
+			t.Errorf("%s without a key: status %d", path, w.Code)
+		}
+	}
+}
+
+func TestClockEndpoint(t *testing.T) {
+	w := get(t, newServer(t, nil), "/api/clock")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+
+	var body struct {
+		Now      time.Time `json:"now"`
+		Timezone string    `json:"timezone"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if !body.Now.Equal(departureDay) || body.Timezone != "Europe/Moscow" {
+		t.Errorf("clock = %+v, want tracker time %v in Europe/Moscow", body, departureDay)
+	}
+}
+
+func TestTimetableEndpoint(t *testing.T) {
+	h := newServer(t, nil)
+
+	tests := []struct {
+		target  string
+		header  []string
+		lang    string
+		station string
+	}{
+		{"/api/timetable", nil, "ru", "Москва"},
+		{"/api/timetable?lang=en", nil, "en", "Moskva"},
+		{"/api/timetable", []string{"Accept-Language", "en-US,en;q=0.9"}, "en", "Moskva"},
+	}
+
+	for _, tt := range tests {
+		w := get(t, h, tt.target, tt.header...)
+		if w.Code != http.StatusOK || w.Header().Get("Content-Language") != tt.lang {
+			t.Fatalf("%s: status %d, language %q", tt.target, w.Code, w.Header().Get("Content-Language"))
+		}
+
+		var timetable timeline.Timetable
+		if err := json.NewDecoder(w.Body).Decode(&timetable); err != nil {
+			t.Fatal(err)
+		}
+		if len(timetable.Rows) == 0 || timetable.Rows[0].Station != tt.station {
+			t.Errorf("%s %v: first row %+v, want %s", tt.target, tt.header, timetable.Rows, tt.station)
+		}
+	}
+}
+
+func TestCallsEndpoint(t *testing.T) {
+	h := newServer(t, nil)
+
+	tests := []struct {
+		query   string
+		status  int
+		windows int
+	}{
+		{"", http.StatusOK, 1},
+		{"?at=2025-10-07T00:00:00%2B03:00&hours=48", http.StatusOK, 2},
+		{"?from=0&until=24&hours=12", http.StatusOK, 1},
+		{"?at=yesterday", http.StatusBadRequest, 0},
+		{"?hours=0", http.StatusBadRequest, 0},
+		{"?hours=169", http.StatusBadRequest, 0},
+		{"?from=22&until=9", http.StatusBadRequest, 0},
+		{"?until=25", http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			w := get(t, h, "/api/calls"+tt.query)
+			if w.Code != tt.status {
+				t.Fatalf("status %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var body struct {
+				Windows []json.RawMessage `json:"windows"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if len(body.Windows) != tt.windows {
+				t.Errorf("expected %d windows, got %d", tt.windows, len(body.Windows))
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"reyna-train-tracker/internal/webhook"
+)
+
+// subscribeRequest тело POST /api/webhooks
+type subscribeRequest struct {
+	URL    string              `json:"url"`
+	Secret string              `json:"secret"` // Пусто - сгенерировать
+	Events []webhook.EventType `json:"events"` // Пусто - все события
+}
+
+// handleSubscribe регистрирует вебхук. Секрет возвращается только в этом ответе
+func (s *Server) handleSubscribe(w http.ResponseWriter, r *http.Request) {
+	var req subscribeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	sub, err := s.Webhooks.Subscribe(req.URL, req.Secret, req.Events)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, sub)
+}
+
+// handleSubscriptions список подписок без секретов
+func (s *Server) handleSubscriptions(w http.ResponseWriter, r *http.Request) {
+	subs := s.Webhooks.Store.Subscriptions()
+	for i := range subs {
+		subs[i] = subs[i].Public()
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"events":        webhook.EventTypes(),
+		"subscriptions": subs,
+	})
+}
+
+func (s *Server) handleUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	err := s.Webhooks.Store.DeleteSubscription(r.PathValue("id"))
+	switch {
+	case errors.Is(err, webhook.ErrSubscriptionNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleDeadLetters доставки, от которых отказались после всех попыток
+func (s *Server) handleDeadLetters(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"dead_letters": s.Webhooks.Store.DeadLetters(),
+	})
+}
+
+// handleReplay ставит мёртвое письмо в очередь заново; при новой неудаче оно вернётся
+// в мёртвые письма с новым ID
+func (s *Server) handleReplay(w http.ResponseWriter, r *http.Request) {
+	letter, err := s.Webhooks.Replay(r.PathValue("id"))
+	switch {
+	case errors.Is(err, webhook.ErrDeadLetterNotFound), errors.Is(err, webhook.ErrSubscriptionNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, webhook.ErrDispatcherClosed):
+		writeError(w, http.StatusServiceUnavailable, err.Error())
+	case err != nil:
+		writeError(w, http.StatusConflict, err.Error())
+	default:
+		writeJSON(w, http.StatusAccepted, letter)
+	}
+}
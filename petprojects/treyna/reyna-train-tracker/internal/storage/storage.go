@@ -0,0 +1,386 @@
+package storage
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/models"
+)
+
+// schemaVersion версия раскладки бакетов; меняется вместе с форматом записей
+const schemaVersion = "1"
+
+// Бакеты базы. stations и events содержат вложенные бакеты:
+// по одному на маршрут и на поездку соответственно
+var (
+	bucketMeta     = []byte("meta")
+	bucketRoutes   = []byte("routes")
+	bucketStations = []byte("stations")
+	bucketTrips    = []byte("trips")
+	bucketEvents   = []byte("events")
+
+	keySchemaVersion = []byte("schema_version")
+)
+
+// ErrNotFound маршрут или поездка отсутствуют в базе
+var ErrNotFound = errors.New("not found")
+
+// Route маршрут поезда
+type Route struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	StartTime     time.Time `json:"start_time"`
+	TotalDistance int       `json:"total_distance"`
+	StationCount  int       `json:"station_count"`
+	ImportedAt    time.Time `json:"imported_at"`
+}
+
+// Trip одна поездка по маршруту; к ней привязываются наблюдаемые события
+type Trip struct {
+	ID        string    `json:"id"`
+	RouteID   string    `json:"route_id"`
+	StartTime time.Time `json:"start_time"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Store встроенное хранилище маршрутов, станций, поездок и событий (bbolt).
+// Файл базы открывается одним процессом: второй получит ошибку по таймауту
+type Store struct {
+	db *bolt.DB
+}
+
+// Open открывает (или создаёт) базу и заводит недостающие бакеты
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open storage %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{bucketMeta, bucketRoutes, bucketStations, bucketTrips, bucketEvents} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+
+		meta := tx.Bucket(bucketMeta)
+		switch version := meta.Get(keySchemaVersion); {
+		case version == nil:
+			return meta.Put(keySchemaVersion, []byte(schemaVersion))
+		case string(version) != schemaVersion:
+			return fmt.Errorf("unsupported storage schema version %s (expected %s)", version, schemaVersion)
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to init storage: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Path путь к файлу базы
+func (s *Store) Path() string {
+	return s.db.Path()
+}
+
+// Close закрывает базу
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// SaveRoute сохраняет маршрут и полностью заменяет его станции
+func (s *Store) SaveRoute(route Route, stations []models.StationInfo) error {
+	if route.ID == "" {
+		return errors.New("route id is required")
+	}
+	route.StationCount = len(stations)
+	if route.ImportedAt.IsZero() {
+		route.ImportedAt = time.Now()
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := putJSON(tx.Bucket(bucketRoutes), []byte(route.ID), route); err != nil {
+			return err
+		}
+
+		all := tx.Bucket(bucketStations)
+		if all.Bucket([]byte(route.ID)) != nil {
+			if err := all.DeleteBucket([]byte(route.ID)); err != nil {
+				return err
+			}
+		}
+		b, err := all.CreateBucket([]byte(route.ID))
+		if err != nil {
+			return err
+		}
+
+		for _, station := range stations {
+			if err := putJSON(b, uint64Key(uint64(station.ID)), station); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Route возвращает маршрут по ID
+func (s *Store) Route(id string) (Route, error) {
+	var route Route
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return getJSON(tx.Bucket(bucketRoutes), []byte(id), &route)
+	})
+	if err != nil {
+		return Route{}, fmt.Errorf("route %q: %w", id, err)
+	}
+	return route, nil
+}
+
+// Routes возвращает все маршруты по возрастанию ID
+func (s *Store) Routes() ([]Route, error) {
+	var routes []Route
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketRoutes).ForEach(func(_, v []byte) error {
+			var route Route
+			if err := json.Unmarshal(v, &route); err != nil {
+				return err
+			}
+			routes = append(routes, route)
+			return nil
+		})
+	})
+	return routes, err
+}
+
+// Stations возвращает станции маршрута в порядке следования
+func (s *Store) Stations(routeID string) ([]models.StationInfo, error) {
+	var stations []models.StationInfo
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketStations).Bucket([]byte(routeID))
+		if b == nil {
+			return fmt.Errorf("stations of route %q: %w", routeID, ErrNotFound)
+		}
+
+		// Ключи - ID станций в big-endian, поэтому курсор идёт по порядку маршрута
+		return b.ForEach(func(_, v []byte) error {
+			var station models.StationInfo
+			if err := json.Unmarshal(v, &station); err != nil {
+				return err
+			}
+			stations = append(stations, station)
+			return nil
+		})
+	})
+	return stations, err
+}
+
+// EnsureTrip создаёт поездку, если её ещё нет, и возвращает сохранённую запись
+func (s *Store) EnsureTrip(trip Trip) (Trip, error) {
+	if !journal.ValidTripID(trip.ID) {
+		return Trip{}, fmt.Errorf("invalid trip id: %q", trip.ID)
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		trips := tx.Bucket(bucketTrips)
+		if err := getJSON(trips, []byte(trip.ID), &trip); !errors.Is(err, ErrNotFound) {
+			return err
+		}
+
+		if tx.Bucket(bucketRoutes).Get([]byte(trip.RouteID)) == nil {
+			return fmt.Errorf("route %q: %w", trip.RouteID, ErrNotFound)
+		}
+		if trip.CreatedAt.IsZero() {
+			trip.CreatedAt = time.Now()
+		}
+		if _, err := tx.Bucket(bucketEvents).CreateBucketIfNotExists([]byte(trip.ID)); err != nil {
+			return err
+		}
+		return putJSON(trips, []byte(trip.ID), trip)
+	})
+	if err != nil {
+		return Trip{}, err
+	}
+	return trip, nil
+}
+
+// Trip возвращает поездку по ID
+func (s *Store) Trip(id string) (Trip, error) {
+	var trip Trip
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return getJSON(tx.Bucket(bucketTrips), []byte(id), &trip)
+	})
+	if err != nil {
+		return Trip{}, fmt.Errorf("trip %q: %w", id, err)
+	}
+	return trip, nil
+}
+
+// Trips возвращает все поездки по времени начала
+func (s *Store) Trips() ([]Trip, error) {
+	var trips []Trip
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketTrips).ForEach(func(_, v []byte) error {
+			var trip Trip
+			if err := json.Unmarshal(v, &trip); err != nil {
+				return err
+			}
+			trips = append(trips, trip)
+			return nil
+		})
+	})
+	sort.SliceStable(trips, func(i, j int) bool {
+		return trips[i].StartTime.Before(trips[j].StartTime)
+	})
+	return trips, err
+}
+
+// AppendEvent дописывает событие поездки и присваивает ему следующий номер
+func (s *Store) AppendEvent(tripID string, e journal.Event) (journal.Event, error) {
+	if err := e.Validate(); err != nil {
+		return journal.Event{}, err
+	}
+	if e.RecordedAt.IsZero() {
+		e.RecordedAt = time.Now()
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tripEvents(tx, tripID)
+		if err != nil {
+			return err
+		}
+
+		// Номер берём после последней записи: импортированные события
+		// сохраняют свои номера, и счётчик бакета о них не знает
+		last, _ := b.Cursor().Last()
+		e.Seq = 1
+		if last != nil {
+			e.Seq = binary.BigEndian.Uint64(last) + 1
+		}
+		return putJSON(b, uint64Key(e.Seq), e)
+	})
+	if err != nil {
+		return journal.Event{}, err
+	}
+	return e, nil
+}
+
+// ImportEvents переносит события с их исходными номерами.
+// Уже сохранённые номера пропускаются, поэтому повторный импорт ничего не дублирует
+func (s *Store) ImportEvents(tripID string, events []journal.Event) (int, error) {
+	imported := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tripEvents(tx, tripID)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if e.Seq == 0 {
+				return fmt.Errorf("event without seq in trip %q", tripID)
+			}
+			key := uint64Key(e.Seq)
+			if b.Get(key) != nil {
+				continue
+			}
+			if err := putJSON(b, key, e); err != nil {
+				return err
+			}
+			imported++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return imported, nil
+}
+
+// Events возвращает события поездки по возрастанию номера
+func (s *Store) Events(tripID string) ([]journal.Event, error) {
+	var events []journal.Event
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b, err := tripEvents(tx, tripID)
+		if err != nil {
+			return err
+		}
+
+		return b.ForEach(func(_, v []byte) error {
+			var e journal.Event
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			events = append(events, e)
+			return nil
+		})
+	})
+	return events, err
+}
+
+// TripLog журнал одной поездки поверх базы (реализует journal.Recorder)
+type TripLog struct {
+	store  *Store
+	tripID string
+}
+
+// TripLog возвращает журнал поездки; сама поездка заводится через EnsureTrip
+func (s *Store) TripLog(tripID string) *TripLog {
+	return &TripLog{store: s, tripID: tripID}
+}
+
+// Append дописывает событие поездки
+func (l *TripLog) Append(e journal.Event) (journal.Event, error) {
+	return l.store.AppendEvent(l.tripID, e)
+}
+
+// Events читает все события поездки
+func (l *TripLog) Events() ([]journal.Event, error) {
+	return l.store.Events(l.tripID)
+}
+
+// Close ничего не делает: базу закрывает её владелец
+func (l *TripLog) Close() error {
+	return nil
+}
+
+// tripEvents бакет событий поездки
+func tripEvents(tx *bolt.Tx, tripID string) (*bolt.Bucket, error) {
+	b := tx.Bucket(bucketEvents).Bucket([]byte(tripID))
+	if b == nil {
+		return nil, fmt.Errorf("trip %q: %w", tripID, ErrNotFound)
+	}
+	return b, nil
+}
+
+// uint64Key ключ в big-endian: побайтовый порядок совпадает с числовым
+func uint64Key(n uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, n)
+	return key
+}
+
+func putJSON(b *bolt.Bucket, key []byte, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode %T: %w", v, err)
+	}
+	return b.Put(key, data)
+}
+
+func getJSON(b *bolt.Bucket, key []byte, v interface{}) error {
+	data := b.Get(key)
+	if data == nil {
+		return ErrNotFound
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode %T: %w", v, err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/models"
+)
+
+var start = time.Date(2025, 10, 12, 20, 0, 0, 0, time.UTC)
+
+// openSeeded база с маршрутом из трёх станций и поездкой по нему
+func openSeeded(t *testing.T, path string) *Store {
+	t.Helper()
+	store, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stations := []models.StationInfo{
+		{ID: 10, Name: "C", ArrivalTime: start.Add(4 * time.Hour)},
+		{ID: 1, Name: "A", DepartureTime: start},
+		{ID: 2, Name: "B", ArrivalTime: start.Add(2 * time.Hour)},
+	}
+	if err := store.SaveRoute(Route{ID: "test", Name: "A - C", StartTime: start}, stations); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.EnsureTrip(Trip{ID: "2025-10-12", RouteID: "test", StartTime: start}); err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+// TestStoreSurvivesRestart маршрут, поездка и события читаются после переоткрытия базы,
+// а нумерация событий продолжается
+func TestStoreSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reyna.db")
+	store := openSeeded(t, path)
+	store.AppendEvent("2025-10-12", journal.Event{Type: journal.EventDeparture, At: start, StationID: 1})
+	store.AppendEvent("2025-10-12", journal.Event{Type: journal.EventDelay, At: start.Add(time.Hour), Delay: time.Minute})
+	store.Close()
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	route, err := reopened.Route("test")
+	if err != nil || route.StationCount != 3 || !route.StartTime.Equal(start) {
+		t.Fatalf("route: %+v, %v", route, err)
+	}
+	stations, _ := reopened.Stations("test")
+	if len(stations) != 3 || stations[0].ID != 1 || stations[1].ID != 2 || stations[2].ID != 10 {
+		t.Errorf("stations must follow the route order: %+v", stations)
+	}
+
+	log := reopened.TripLog("2025-10-12")
+	e, err := log.Append(journal.Event{Type: journal.EventArrival, At: start.Add(2 * time.Hour), Station: "B"})
+	if err != nil || e.Seq != 3 {
+		t.Fatalf("numbering must continue after restart: %+v, %v", e, err)
+	}
+	events, _ := log.Events()
+	steps := journal.Replay(stations, events, func(time.Time) *models.CurrentPosition { return nil })
+	if len(steps) != 3 || steps[1].Delay != time.Minute {
+		t.Errorf("unexpected replay: %+v", steps)
+	}
+}
+
+func TestImportEvents(t *testing.T) {
+	store := openSeeded(t, filepath.Join(t.TempDir(), "reyna.db"))
+	defer store.Close()
+
+	event := func(seq uint64) journal.Event {
+		return journal.Event{Seq: seq, Type: journal.EventDelay, At: start.Add(time.Duration(seq) * time.Hour)}
+	}
+	tests := []struct {
+		name    string
+		trip    string
+		events  []journal.Event
+		want    int
+		wantErr bool
+	}{
+		{name: "first import", trip: "2025-10-12", events: []journal.Event{event(1), event(2), event(5)}, want: 3},
+		{name: "repeat skips known seqs", trip: "2025-10-12", events: []journal.Event{event(2), event(5), event(6)}, want: 1},
+		{name: "event without seq", trip: "2025-10-12", events: []journal.Event{event(0)}, wantErr: true},
+		{name: "unknown trip", trip: "2025-10-13", events: []journal.Event{event(1)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := store.ImportEvents(tt.trip, tt.events)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("%s: imported %d, %v", tt.name, got, err)
+		}
+	}
+
+	// Новые события идут после самого большого импортированного номера
+	e, err := store.AppendEvent("2025-10-12", event(0))
+	if err != nil || e.Seq != 7 {
+		t.Errorf("append after import: %+v, %v", e, err)
+	}
+}
+
+func TestEnsureTrip(t *testing.T) {
+	store := openSeeded(t, filepath.Join(t.TempDir(), "reyna.db"))
+	defer store.Close()
+
+	tests := []struct {
+		name    string
+		trip    Trip
+		wantErr bool
+	}{
+		{name: "existing trip is kept", trip: Trip{ID: "2025-10-12", RouteID: "other"}},
+		{name: "unknown route", trip: Trip{ID: "2025-10-20", RouteID: "other"}, wantErr: true},
+		{name: "invalid id", trip: Trip{ID: "../trip", RouteID: "test"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		trip, err := store.EnsureTrip(tt.trip)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		if err == nil && trip.RouteID != "test" {
+			t.Errorf("%s: stored trip was overwritten: %+v", tt.name, trip)
+		}
+	}
+	if _, err := store.Trip("2025-10-20"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("trip with an unknown route must not be created: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package timeline
+
+import (
+	"html/template"
+	"io"
+
+	"reyna-train-tracker/internal/i18n"
+)
+
+// pageTemplate самодостаточная страница: стили встроены, внешних ресурсов нет
+var pageTemplate = template.Must(template.New("timeline").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Timetable.Title}}</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2em; color: #222; }
+h1 { font-size: 1.4em; margin-bottom: 0.2em; }
+p { margin: 0.3em 0; color: #555; }
+table { border-collapse: collapse; margin-top: 1em; font-size: 0.9em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; white-space: nowrap; }
+th { background: #f0f0f0; position: sticky; top: 0; }
+td.num { text-align: right; font-variant-numeric: tabular-nums; }
+tr:nth-child(even) td { background: #fafafa; }
+tr.major td { background: #fff4d6; font-weight: 600; }
+</style>
+</head>
+<body>
+<h1>{{.Timetable.Title}}</h1>
+<p>{{.Timetable.Summary}}</p>
+<p>{{.Timetable.Legend}}</p>
+<table>
+<thead><tr>{{range .Timetable.Columns}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{- range .Timetable.Rows}}
+<tr{{if .Major}} class="major"{{end}}><td class="num">{{.Number}}</td><td>{{if .Major}}★ {{end}}{{.Station}}</td><td class="num">{{.Km}}</td><td class="num">{{.Day}}</td><td>{{.Arrival}}</td><td>{{.Departure}}</td><td>{{.LocalArrival}}</td><td>{{.LocalDeparture}}</td><td>{{.Stand}}</td><td>{{.Timezone}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// renderHTML самодостаточная HTML-страница с расписанием
+func renderHTML(w io.Writer, t Timetable, lang i18n.Lang) error {
+	return pageTemplate.Execute(w, struct {
+		Lang      i18n.Lang
+		Timetable Timetable
+	}{lang, t})
+}
@@ -0,0 +1,107 @@
+package timeline
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Отметки крупной станции в текстовой таблице и Markdown
+const (
+	majorMark = "★ "
+	plainMark = "  "
+)
+
+// renderText таблица с выравниванием по ширине колонок (ширина - в символах, не байтах)
+func renderText(w io.Writer, t Timetable) error {
+	rows := make([][]string, len(t.Rows))
+	for i, row := range t.Rows {
+		rows[i] = row.cells()
+		mark := plainMark
+		if row.Major {
+			mark = majorMark
+		}
+		rows[i][1] = mark + rows[i][1]
+	}
+
+	header := append([]string(nil), t.Columns...)
+	header[1] = plainMark + header[1]
+
+	widths := make([]int, len(header))
+	for _, cells := range append([][]string{header}, rows...) {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n%s\n%s\n\n", t.Title, t.Summary, t.Legend)
+	writeTextRow(&b, header, widths)
+
+	separators := make([]string, len(widths))
+	for i, width := range widths {
+		separators[i] = strings.Repeat("-", width)
+	}
+	writeTextRow(&b, separators, widths)
+
+	for _, cells := range rows {
+		writeTextRow(&b, cells, widths)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeTextRow(b *strings.Builder, cells []string, widths []int) {
+	for i, cell := range cells {
+		if i > 0 {
+			b.WriteString(" | ")
+		}
+		b.WriteString(cell)
+		// Последнюю колонку не добиваем пробелами
+		if i < len(cells)-1 {
+			b.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+		}
+	}
+	b.WriteString("\n")
+}
+
+// renderMarkdown таблица GitHub Markdown; крупные станции выделены жирным
+func renderMarkdown(w io.Writer, t Timetable) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n%s\n\n%s\n\n", escapeMarkdown(t.Title), escapeMarkdown(t.Summary), escapeMarkdown(t.Legend))
+
+	b.WriteString("|")
+	for _, column := range t.Columns {
+		fmt.Fprintf(&b, " %s |", escapeMarkdown(column))
+	}
+	b.WriteString("\n|")
+	for range t.Columns {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+
+	for _, row := range t.Rows {
+		cells := row.cells()
+		b.WriteString("|")
+		for i, cell := range cells {
+			cell = escapeMarkdown(cell)
+			if i == 1 && row.Major {
+				cell = "**" + majorMark + cell + "**"
+			}
+			fmt.Fprintf(&b, " %s |", cell)
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownEscaper экранирует символы, ломающие таблицу и разметку
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`")
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
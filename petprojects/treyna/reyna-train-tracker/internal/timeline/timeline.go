@@ -0,0 +1,171 @@
+package timeline
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/utils"
+)
+
+// Format формат вывода расписания
+type Format string
+
+const (
+	FormatText     Format = "text"
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+)
+
+// noTime прочерк вместо прибытия на первой станции и отправления с последней
+const noTime = "—"
+
+// ParseFormat разбирает название формата (text, markdown/md, html)
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "text", "txt":
+		return FormatText, nil
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "html":
+		return FormatHTML, nil
+	}
+	return "", fmt.Errorf("unknown timeline format: %q (expected text, markdown or html)", s)
+}
+
+// Row строка расписания, готовая к выводу
+type Row struct {
+	Number         int
+	Station        string
+	Km             int
+	Day            int // День поездки по местному календарю станции прибытия
+	Arrival        string
+	Departure      string
+	LocalArrival   string
+	LocalDeparture string
+	Stand          string
+	Timezone       string // Пояс и сдвиг относительно Москвы
+	Major          bool
+}
+
+// Timetable полное расписание маршрута
+type Timetable struct {
+	Title   string
+	Summary string
+	Legend  string
+	Columns []string
+	Rows    []Row
+}
+
+// Build собирает расписание: время по Москве и по местным часам каждой станции
+func Build(route models.RouteData, stations []models.StationInfo, lang i18n.Lang) Timetable {
+	t := Timetable{
+		Title:   i18n.T(lang, "timeline.title", i18n.StationName(lang, route.Name)),
+		Summary: i18n.T(lang, "timeline.summary", moscowTime(route.StartTime)+"."+route.StartTime.Format("2006"), len(stations), route.TotalDistance),
+		Legend:  i18n.T(lang, "timeline.legend"),
+		Columns: []string{
+			i18n.T(lang, "timeline.col_number"),
+			i18n.T(lang, "timeline.col_station"),
+			i18n.T(lang, "timeline.col_km"),
+			i18n.T(lang, "timeline.col_day"),
+			i18n.T(lang, "timeline.col_arrival"),
+			i18n.T(lang, "timeline.col_departure"),
+			i18n.T(lang, "timeline.col_local_arr"),
+			i18n.T(lang, "timeline.col_local_dep"),
+			i18n.T(lang, "timeline.col_stand"),
+			i18n.T(lang, "timeline.col_timezone"),
+		},
+	}
+	if len(stations) == 0 {
+		return t
+	}
+
+	startTZ := stations[0].Timezone
+	last := len(stations) - 1
+	for i, station := range stations {
+		row := Row{
+			Number:         i + 1,
+			Station:        i18n.StationName(lang, station.Name),
+			Km:             station.DistanceFromStart,
+			Day:            tracker.CalendarDay(route.StartTime, startTZ, station.ArrivalTime, station.Timezone),
+			Arrival:        moscowTime(station.ArrivalTime),
+			Departure:      moscowTime(station.DepartureTime),
+			LocalArrival:   localTime(station.ArrivalTime, station.Timezone),
+			LocalDeparture: localTime(station.DepartureTime, station.Timezone),
+			Stand:          i18n.FormatDuration(lang, station.StandDuration),
+			Timezone:       timezone(lang, station),
+			Major:          station.IsMajor,
+		}
+
+		// Поезд отправляется с первой станции и только прибывает на последнюю
+		if i == 0 {
+			row.Arrival, row.LocalArrival, row.Stand = noTime, noTime, noTime
+		}
+		if i == last {
+			row.Departure, row.LocalDeparture, row.Stand = noTime, noTime, noTime
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t
+}
+
+// Render выводит расписание маршрута в формате format
+func Render(w io.Writer, format Format, lang i18n.Lang, route models.RouteData, stations []models.StationInfo) error {
+	t := Build(route, stations, lang)
+	switch format {
+	case FormatText:
+		return renderText(w, t)
+	case FormatMarkdown:
+		return renderMarkdown(w, t)
+	case FormatHTML:
+		return renderHTML(w, t, lang)
+	}
+	return fmt.Errorf("unknown timeline format: %q", format)
+}
+
+// cells значения строки в порядке колонок
+func (r Row) cells() []string {
+	return []string{
+		strconv.Itoa(r.Number),
+		r.Station,
+		strconv.Itoa(r.Km),
+		strconv.Itoa(r.Day),
+		r.Arrival,
+		r.Departure,
+		r.LocalArrival,
+		r.LocalDeparture,
+		r.Stand,
+		r.Timezone,
+	}
+}
+
+// moscowTime время по Москве, как в расписании РЖД
+func moscowTime(t time.Time) string {
+	if moscow, err := utils.ConvertToTimezone(t, "Europe/Moscow"); err == nil {
+		t = moscow
+	}
+	return t.Format("15:04 02.01")
+}
+
+// localTime время по часам станции
+func localTime(t time.Time, tz string) string {
+	local, err := utils.ConvertToTimezone(t, tz)
+	if err != nil {
+		return noTime
+	}
+	return local.Format("15:04 02.01")
+}
+
+// timezone пояс станции со сдвигом относительно Москвы в момент прибытия: "Asia/Irkutsk (МСК+5)"
+func timezone(lang i18n.Lang, station models.StationInfo) string {
+	diff, err := utils.GetTimezoneDifference("Europe/Moscow", station.Timezone, station.ArrivalTime)
+	if err != nil || diff == 0 {
+		return station.Timezone
+	}
+	return fmt.Sprintf("%s (%s)", station.Timezone, i18n.T(lang, "timeline.moscow_offset", int(diff.Hours())))
+}
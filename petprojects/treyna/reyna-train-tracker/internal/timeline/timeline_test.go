@@ -0,0 +1,168 @@
+package timeline
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/i18n"
+	"reyna-train-tracker/internal/models"
+)
+
+var msk = time.FixedZone("MSK", 3*3600)
+
+// testRoute три станции: отправление вечером по Москве, Иркутск после местной полуночи
+func testRoute() (models.RouteData, []models.StationInfo) {
+	stations := []models.StationInfo{
+		{
+			Name:          "Москва",
+			Timezone:      "Europe/Moscow",
+			ArrivalTime:   time.Date(2025, 10, 6, 22, 10, 0, 0, msk),
+			DepartureTime: time.Date(2025, 10, 6, 22, 30, 0, 0, msk),
+			StandDuration: 20 * time.Minute,
+			IsMajor:       true,
+		},
+		{
+			Name:              "Иркутск",
+			Timezone:          "Asia/Irkutsk",
+			ArrivalTime:       time.Date(2025, 10, 9, 20, 0, 0, 0, msk),
+			DepartureTime:     time.Date(2025, 10, 9, 20, 30, 0, 0, msk),
+			StandDuration:     30 * time.Minute,
+			DistanceFromStart: 5000,
+			IsMajor:           true,
+		},
+		{
+			Name:              "Хабаровск 1",
+			Timezone:          "Asia/Vladivostok",
+			ArrivalTime:       time.Date(2025, 10, 14, 3, 2, 0, 0, msk),
+			DepartureTime:     time.Date(2025, 10, 14, 3, 2, 0, 0, msk),
+			DistanceFromStart: 8500,
+		},
+	}
+	route := models.RouteData{
+		Name:          "Москва - Хабаровск",
+		TotalDistance: 8500,
+		StartTime:     stations[0].DepartureTime,
+		Stations:      stations,
+	}
+	return route, stations
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Format
+		wantErr bool
+	}{
+		{"", FormatText, false},
+		{"TXT", FormatText, false},
+		{" md ", FormatMarkdown, false},
+		{"markdown", FormatMarkdown, false},
+		{"html", FormatHTML, false},
+		{"pdf", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseFormat(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseFormat(%q) = %q, %v", tt.in, got, err)
+		}
+	}
+}
+
+func TestBuildRows(t *testing.T) {
+	route, stations := testRoute()
+	timetable := Build(route, stations, i18n.Russian)
+
+	tests := []struct {
+		station        string
+		day            int
+		arrival        string
+		departure      string
+		localArrival   string
+		localDeparture string
+		timezone       string
+	}{
+		{"Москва", 1, noTime, "22:30 06.10", noTime, "22:30 06.10", "Europe/Moscow"},
+		// 20:00 по Москве - уже 10 октября в Иркутске
+		{"Иркутск", 5, "20:00 09.10", "20:30 09.10", "01:00 10.10", "01:30 10.10", "Asia/Irkutsk (МСК+5)"},
+		{"Хабаровск 1", 9, "03:02 14.10", noTime, "10:02 14.10", noTime, "Asia/Vladivostok (МСК+7)"},
+	}
+
+	if len(timetable.Rows) != len(tests) {
+		t.Fatalf("expected %d rows, got %d", len(tests), len(timetable.Rows))
+	}
+	for i, tt := range tests {
+		row := timetable.Rows[i]
+		if row.Number != i+1 || row.Station != tt.station || row.Day != tt.day {
+			t.Errorf("row %d = %+v", i, row)
+		}
+		if row.Arrival != tt.arrival || row.Departure != tt.departure ||
+			row.LocalArrival != tt.localArrival || row.LocalDeparture != tt.localDeparture {
+			t.Errorf("row %d times = %s %s / %s %s", i, row.Arrival, row.Departure, row.LocalArrival, row.LocalDeparture)
+		}
+		if row.Timezone != tt.timezone {
+			t.Errorf("row %d timezone = %q, want %q", i, row.Timezone, tt.timezone)
+		}
+	}
+	if timetable.Rows[2].Stand != noTime {
+		t.Errorf("last station must have no stand, got %q", timetable.Rows[2].Stand)
+	}
+}
+
+func TestRender(t *testing.T) {
+	route, stations := testRoute()
+
+	tests := []struct {
+		format Format
+		lang   i18n.Lang
+		want   []string
+	}{
+		{FormatText, i18n.Russian, []string{"Расписание: Москва - Хабаровск", "★ Иркутск", "|   Хабаровск 1 |", "01:00 10.10", "Asia/Irkutsk (МСК+5)\n"}},
+		{FormatMarkdown, i18n.Russian, []string{"# Расписание", "| --- |", "| **★ Иркутск** |", "| Хабаровск 1 |"}},
+		{FormatHTML, i18n.Russian, []string{`<html lang="ru">`, `<tr class="major">`, "<td>★ Иркутск</td>", "<td>10:02 14.10</td>"}},
+		{FormatText, i18n.English, []string{"Timetable: Moskva - Khabarovsk", "Irkutsk", "MSK+7"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format)+"/"+string(tt.lang), func(t *testing.T) {
+			var b strings.Builder
+			if err := Render(&b, tt.format, tt.lang, route, stations); err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(b.String(), want) {
+					t.Errorf("output has no %q:\n%s", want, b.String())
+				}
+			}
+		})
+	}
+
+	if err := Render(&strings.Builder{}, Format("pdf"), i18n.Russian, route, stations); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestRenderEscapes(t *testing.T) {
+	route, stations := testRoute()
+	stations[1].Name = "Иркутск | <Сорт*>"
+
+	tests := []struct {
+		format  Format
+		want    string
+		notWant string
+	}{
+		{FormatMarkdown, `Иркутск \| <Сорт\*>`, "Иркутск | "},
+		{FormatHTML, "Иркутск | &lt;Сорт*&gt;", "<Сорт"},
+	}
+
+	for _, tt := range tests {
+		var b strings.Builder
+		if err := Render(&b, tt.format, i18n.Russian, route, stations); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(b.String(), tt.want) || strings.Contains(b.String(), tt.notWant) {
+			t.Errorf("%s: station name not escaped:\n%s", tt.format, b.String())
+		}
+	}
+}
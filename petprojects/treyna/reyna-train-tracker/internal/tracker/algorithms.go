@@ -215,8 +215,8 @@ func BuildStationHashMap(stations []models.StationInfo) (map[string]*models.Stat
 // для расчёта средней скорости на последних N отрезках
 func CalculateAverageSpeedSlidingWindow(stations []models.StationInfo, currentIndex int, windowSize int) float64 {
 	if currentIndex < 1 || len(stations) < 2 {
-		// Средняя скорость поезда ~90 км/ч
-		return 90.0
+		// Нет пройденных перегонов - средняя скорость по всему маршруту
+		return RouteAverageSpeed(stations)
 	}
 
 	// Определяем размер окна
@@ -241,7 +241,7 @@ func CalculateAverageSpeedSlidingWindow(stations []models.StationInfo, currentIn
 		return totalDistance / totalTime
 	}
 
-	return 90.0 // Средняя скорость по умолчанию
+	return RouteAverageSpeed(stations)
 }
 
 // PredictArrivalTime предсказывает время прибытия на основе скользящего окна
@@ -251,6 +251,11 @@ func PredictArrivalTime(
 	currentTime time.Time,
 	averageSpeed float64,
 ) time.Time {
+	// Скорость неизвестна - остаётся расписание
+	if averageSpeed <= 0 {
+		return toStation.ArrivalTime
+	}
+
 	// Расстояние до следующей станции
 	distance := float64(toStation.DistanceFromStart - fromStation.DistanceFromStart)
 
@@ -0,0 +1,115 @@
+package tracker
+
+import (
+	"time"
+
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/utils"
+)
+
+// LocalDateChanges ищет смены местной даты у пассажира в интервале (from, until].
+// Дата меняется в местную полночь или при переводе часов на границе поясов,
+// если новый пояс уже живёт следующим днём
+func (t *TrainTracker) LocalDateChanges(from, until time.Time) []models.DateChange {
+	if len(t.Stations) == 0 || !until.After(from) {
+		return nil
+	}
+
+	var borders []models.TimezoneBorder
+	for _, border := range t.timezoneBorders {
+		if border.ETA.After(from) && !border.ETA.After(until) {
+			borders = append(borders, border)
+		}
+	}
+
+	var changes []models.DateChange
+	tz := t.TimezoneAt(from)
+	current := localDate(from, tz)
+
+	// Участки между границами: на каждом действует один пояс
+	for i := 0; i <= len(borders); i++ {
+		end := until
+		if i < len(borders) {
+			end = borders[i].ETA
+		}
+
+		for midnight := current.AddDate(0, 0, 1); !midnight.After(end); midnight = midnight.AddDate(0, 0, 1) {
+			changes = append(changes, models.DateChange{
+				At:       midnight,
+				In:       midnight.Sub(from),
+				NewDate:  midnight,
+				Timezone: tz,
+				Station:  t.Stations[stationIndexAt(t.Stations, midnight)].Name,
+			})
+			current = midnight
+		}
+
+		if i == len(borders) {
+			break
+		}
+
+		border := borders[i]
+		tz = border.To
+		date := localDate(border.ETA, tz)
+		if !sameDay(date, current) {
+			changes = append(changes, models.DateChange{
+				At:         border.ETA,
+				In:         border.ETA.Sub(from),
+				NewDate:    date,
+				Timezone:   tz,
+				Station:    border.PrevStation,
+				ClockShift: true,
+			})
+		}
+		current = date
+	}
+
+	return changes
+}
+
+// CalendarDay номер дня путешествия по местному календарю:
+// день отправления (по часам станции отправления) - первый
+func CalendarDay(start time.Time, startTZ string, at time.Time, tz string) int {
+	from := localDate(start, startTZ)
+	to := localDate(at, tz)
+
+	// Разница дат без учёта часов: полночи могут быть в разных поясах
+	fromUTC := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toUTC := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toUTC.Sub(fromUTC).Hours()/24) + 1
+}
+
+// sameDay совпадают ли календарные даты (пояса могут различаться)
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// stationIndexAt индекс последней станции, до которой поезд доехал к моменту at
+func stationIndexAt(stations []models.StationInfo, at time.Time) int {
+	index := 0
+	for i, station := range stations {
+		if station.ArrivalTime.After(at) {
+			break
+		}
+		index = i
+	}
+	return index
+}
+
+// localDate местная полночь дня, в который попадает момент at в поясе tz
+func localDate(at time.Time, tz string) time.Time {
+	local := at.In(loadLocation(tz))
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+}
+
+// loadLocation загружает пояс; неизвестный пояс считаем московским,
+// как и время расписания
+func loadLocation(tz string) *time.Location {
+	if loc, err := utils.LoadLocation(tz); err == nil {
+		return loc
+	}
+	if loc, err := utils.LoadLocation("Europe/Moscow"); err == nil {
+		return loc
+	}
+	return time.UTC
+}
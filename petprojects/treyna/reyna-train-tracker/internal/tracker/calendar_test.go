@@ -0,0 +1,91 @@
+package tracker_test
+
+import (
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+func TestCalendarDay(t *testing.T) {
+	start := time.Date(2025, 10, 6, 22, 30, 0, 0, time.FixedZone("MSK", 3*3600))
+
+	tests := []struct {
+		name string
+		at   time.Time
+		tz   string
+		want int
+	}{
+		{"отправление", start, "Europe/Moscow", 1},
+		{"до полуночи в Москве", start.Add(time.Hour), "Europe/Moscow", 1},
+		{"после полуночи в Москве", start.Add(2 * time.Hour), "Europe/Moscow", 2},
+		{"в Хабаровске уже завтра", start.Add(time.Hour), "Asia/Vladivostok", 2},
+		{"прибытие", time.Date(2025, 10, 14, 3, 2, 0, 0, time.FixedZone("MSK", 3*3600)), "Asia/Vladivostok", 9},
+		{"неизвестный пояс считается московским", start.Add(time.Hour), "Mars/Olympus", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tracker.CalendarDay(start, "Europe/Moscow", tt.at, tt.tz); got != tt.want {
+				t.Errorf("CalendarDay(%v, %s) = %d, want %d", tt.at, tt.tz, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalDateChanges(t *testing.T) {
+	trainTracker, _ := trackertest.NewTracker(t, trackertest.Config(), departureDay)
+	msk := time.FixedZone("MSK", 3*3600)
+
+	type change struct {
+		at       time.Time
+		timezone string
+		station  string
+	}
+	tests := []struct {
+		name        string
+		from, until time.Time
+		want        []change
+	}{
+		{
+			name:  "первая полночь в Москве",
+			from:  time.Date(2025, 10, 6, 22, 30, 0, 0, msk),
+			until: time.Date(2025, 10, 7, 1, 0, 0, 0, msk),
+			want:  []change{{time.Date(2025, 10, 7, 0, 0, 0, 0, msk), "Europe/Moscow", "Москва"}},
+		},
+		{
+			name:  "граница поясов днём дату не меняет",
+			from:  time.Date(2025, 10, 7, 12, 0, 0, 0, msk),
+			until: time.Date(2025, 10, 7, 15, 0, 0, 0, msk),
+		},
+		{
+			name:  "полночь по омскому времени",
+			from:  time.Date(2025, 10, 8, 20, 0, 0, 0, msk),
+			until: time.Date(2025, 10, 8, 23, 0, 0, 0, msk),
+			want:  []change{{time.Date(2025, 10, 8, 21, 0, 0, 0, msk), "Asia/Omsk", "Барабинск"}},
+		},
+		{
+			name:  "пустой интервал",
+			from:  time.Date(2025, 10, 8, 23, 0, 0, 0, msk),
+			until: time.Date(2025, 10, 8, 20, 0, 0, 0, msk),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trainTracker.LocalDateChanges(tt.from, tt.until)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d date changes, got %+v", len(tt.want), got)
+			}
+			for i, want := range tt.want {
+				if !got[i].At.Equal(want.at) || got[i].Timezone != want.timezone || got[i].Station != want.station {
+					t.Errorf("change %d = %+v, want %+v", i, got[i], want)
+				}
+				if got[i].In != want.at.Sub(tt.from) {
+					t.Errorf("change %d in %v, want %v", i, got[i].In, want.at.Sub(tt.from))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package tracker
+
+import (
+	"time"
+
+	"reyna-train-tracker/internal/models"
+)
+
+// Параметры планировщика звонков
+const (
+	DefaultAwakeFrom  = 9                // С какого часа удобно звонить
+	DefaultAwakeUntil = 22               // До какого часа удобно звонить
+	callStep          = 15 * time.Minute // Шаг перебора: окна выровнены по четверти часа
+)
+
+// CallWindows окна для звонка в интервале [from, until): местный час и у пассажира,
+// и в Москве лежит в [awakeFrom, awakeUntil). Пояс пассажира берётся с учётом
+// границ на перегонах, поэтому окна сдвигаются по мере движения на восток
+func (t *TrainTracker) CallWindows(from, until time.Time, awakeFrom, awakeUntil int) []models.CallWindow {
+	moscow := loadLocation("Europe/Moscow")
+	awake := func(at time.Time, loc *time.Location) bool {
+		hour := at.In(loc).Hour()
+		return hour >= awakeFrom && hour < awakeUntil
+	}
+
+	var windows []models.CallWindow
+	var current *models.CallWindow
+	for at := from.Truncate(callStep); at.Before(until); at = at.Add(callStep) {
+		tz := t.TimezoneAt(at)
+		if awake(at, moscow) && awake(at, loadLocation(tz)) {
+			if current == nil {
+				start := at
+				if start.Before(from) {
+					start = from
+				}
+				current = &models.CallWindow{Start: start, Timezone: tz}
+			}
+			continue
+		}
+
+		if current != nil {
+			windows = append(windows, t.closeWindow(*current, at))
+			current = nil
+		}
+	}
+	if current != nil {
+		windows = append(windows, t.closeWindow(*current, until))
+	}
+	return windows
+}
+
+// closeWindow завершает окно в момент end и находит стоянки внутри него
+func (t *TrainTracker) closeWindow(w models.CallWindow, end time.Time) models.CallWindow {
+	w.End = end
+	w.Duration = end.Sub(w.Start)
+	for _, station := range t.Stations {
+		if station.StandDuration > 0 && station.ArrivalTime.Before(end) && station.DepartureTime.After(w.Start) {
+			w.Stops = append(w.Stops, station.Name)
+		}
+	}
+	return w
+}
@@ -0,0 +1,93 @@
+package tracker_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+func TestCallWindows(t *testing.T) {
+	trainTracker, _ := trackertest.NewTracker(t, trackertest.Config(), departureDay)
+	msk := time.FixedZone("MSK", 3*3600)
+	at := func(day, hour, minute int) time.Time { return time.Date(2025, 10, day, hour, minute, 0, 0, msk) }
+
+	type window struct {
+		start, end time.Time
+		timezone   string
+	}
+	tests := []struct {
+		name               string
+		from, until        time.Time
+		awakeFrom, awakeTo int
+		want               []window
+	}{
+		{
+			// За Кировом поезд переходит на екатеринбургское время (МСК+2):
+			// в 22:00 по местным часам в Москве только 20:00
+			name:      "граница поясов сокращает вечер",
+			from:      at(7, 0, 0),
+			until:     at(8, 0, 0),
+			awakeFrom: tracker.DefaultAwakeFrom,
+			awakeTo:   tracker.DefaultAwakeUntil,
+			want:      []window{{at(7, 9, 0), at(7, 20, 0), "Europe/Moscow"}},
+		},
+		{
+			name:      "омское время (МСК+3)",
+			from:      at(8, 0, 0),
+			until:     at(9, 0, 0),
+			awakeFrom: tracker.DefaultAwakeFrom,
+			awakeTo:   tracker.DefaultAwakeUntil,
+			want:      []window{{at(8, 9, 0), at(8, 19, 0), "Asia/Yekaterinburg"}},
+		},
+		{
+			name:      "начало не на четверти часа",
+			from:      at(7, 10, 7),
+			until:     at(7, 11, 0),
+			awakeFrom: tracker.DefaultAwakeFrom,
+			awakeTo:   tracker.DefaultAwakeUntil,
+			want:      []window{{at(7, 10, 7), at(7, 11, 0), "Europe/Moscow"}},
+		},
+		{
+			name:      "ночью звонить некогда",
+			from:      at(7, 0, 0),
+			until:     at(7, 6, 0),
+			awakeFrom: tracker.DefaultAwakeFrom,
+			awakeTo:   tracker.DefaultAwakeUntil,
+		},
+		{
+			name:      "круглосуточно",
+			from:      at(7, 0, 0),
+			until:     at(9, 0, 0),
+			awakeFrom: 0,
+			awakeTo:   24,
+			want:      []window{{at(7, 0, 0), at(9, 0, 0), "Europe/Moscow"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trainTracker.CallWindows(tt.from, tt.until, tt.awakeFrom, tt.awakeTo)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d windows, got %+v", len(tt.want), got)
+			}
+			for i, want := range tt.want {
+				w := got[i]
+				if !w.Start.Equal(want.start) || !w.End.Equal(want.end) || w.Timezone != want.timezone {
+					t.Errorf("window %d = %v - %v (%s), want %v - %v (%s)", i, w.Start, w.End, w.Timezone, want.start, want.end, want.timezone)
+				}
+				if w.Duration != w.End.Sub(w.Start) {
+					t.Errorf("window %d duration %v", i, w.Duration)
+				}
+			}
+		})
+	}
+
+	// Стоянки внутри окна подсказывают, когда связь надёжнее
+	windows := trainTracker.CallWindows(at(7, 0, 0), at(8, 0, 0), tracker.DefaultAwakeFrom, tracker.DefaultAwakeUntil)
+	if len(windows) != 1 || !slices.Contains(windows[0].Stops, "Киров Пасс") || slices.Contains(windows[0].Stops, "Пермь 2") {
+		t.Errorf("unexpected stops in the window: %+v", windows)
+	}
+}
@@ -0,0 +1,75 @@
+package tracker
+
+import (
+	"sort"
+	"time"
+
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/utils"
+)
+
+// Milestones события поездки по расписанию в интервале [from, until]:
+// прибытия и отправления, границы поясов и смены местной даты, по времени.
+// При совпадении моментов порядок: станции, границы, даты
+func (t *TrainTracker) Milestones(from, until time.Time) []models.Milestone {
+	var milestones []models.Milestone
+	within := func(at time.Time) bool {
+		return !at.Before(from) && !at.After(until)
+	}
+
+	last := len(t.Stations) - 1
+	for i, station := range t.Stations {
+		// На первой станции поезд только отправляется, на последней - только прибывает
+		if i > 0 && within(station.ArrivalTime) {
+			milestones = append(milestones, models.Milestone{
+				At:       station.ArrivalTime,
+				Kind:     models.MilestoneArrival,
+				Station:  station.Name,
+				Timezone: station.Timezone,
+			})
+		}
+		if i < last && within(station.DepartureTime) {
+			milestones = append(milestones, models.Milestone{
+				At:       station.DepartureTime,
+				Kind:     models.MilestoneDeparture,
+				Station:  station.Name,
+				Timezone: station.Timezone,
+			})
+		}
+	}
+
+	for _, border := range t.timezoneBorders {
+		if !within(border.ETA) {
+			continue
+		}
+		m := models.Milestone{
+			At:       border.ETA,
+			Kind:     models.MilestoneTimezone,
+			Station:  border.PrevStation,
+			Timezone: border.To,
+		}
+		before, errFrom := utils.OffsetAt(border.From, border.ETA)
+		after, errTo := utils.OffsetAt(border.To, border.ETA)
+		if errFrom == nil && errTo == nil {
+			m.Shift = after - before
+		}
+		milestones = append(milestones, m)
+	}
+
+	// LocalDateChanges ищет смены в (from, until]: начинаем на миг раньше,
+	// чтобы не потерять смену даты ровно в момент from
+	for _, change := range t.LocalDateChanges(from.Add(-time.Nanosecond), until) {
+		milestones = append(milestones, models.Milestone{
+			At:       change.At,
+			Kind:     models.MilestoneDate,
+			Station:  change.Station,
+			Timezone: change.Timezone,
+			Date:     change.NewDate,
+		})
+	}
+
+	sort.SliceStable(milestones, func(i, j int) bool {
+		return milestones[i].At.Before(milestones[j].At)
+	})
+	return milestones
+}
@@ -0,0 +1,104 @@
+package tracker_test
+
+import (
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+func TestMilestonesWindow(t *testing.T) {
+	trainTracker, _ := trackertest.NewTracker(t, trackertest.Config(), departureDay)
+	msk := time.FixedZone("MSK", 3*3600)
+
+	type milestone struct {
+		kind    models.MilestoneKind
+		station string
+		shift   time.Duration
+	}
+	tests := []struct {
+		name        string
+		from, until time.Time
+		want        []milestone
+	}{
+		{
+			name:  "отправление из Москвы",
+			from:  time.Date(2025, 10, 6, 22, 30, 0, 0, msk),
+			until: time.Date(2025, 10, 6, 23, 0, 0, 0, msk),
+			want:  []milestone{{models.MilestoneDeparture, "Москва", 0}},
+		},
+		{
+			name:  "полночь ровно в начале окна",
+			from:  time.Date(2025, 10, 7, 0, 0, 0, 0, msk),
+			until: time.Date(2025, 10, 7, 1, 36, 0, 0, msk),
+			want: []milestone{
+				{models.MilestoneDate, "Москва", 0},
+				{models.MilestoneArrival, "Владимир Пасс", 0},
+				{models.MilestoneDeparture, "Владимир Пасс", 0},
+			},
+		},
+		{
+			name:  "граница с переводом часов",
+			from:  time.Date(2025, 10, 7, 13, 0, 0, 0, msk),
+			until: time.Date(2025, 10, 7, 15, 0, 0, 0, msk),
+			want: []milestone{
+				{models.MilestoneTimezone, "Зуевка", 2 * time.Hour},
+				{models.MilestoneArrival, "Глазов", 0},
+				{models.MilestoneDeparture, "Глазов", 0},
+			},
+		},
+		{
+			name:  "граница без перевода часов",
+			from:  time.Date(2025, 10, 9, 1, 0, 0, 0, msk),
+			until: time.Date(2025, 10, 9, 2, 0, 0, 0, msk),
+			want:  []milestone{{models.MilestoneTimezone, "Новосибирск-Главный", 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trainTracker.Milestones(tt.from, tt.until)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d milestones, got %+v", len(tt.want), got)
+			}
+			for i, want := range tt.want {
+				if got[i].Kind != want.kind || got[i].Station != want.station || got[i].Shift != want.shift {
+					t.Errorf("milestone %d = %+v, want %+v", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestMilestonesWholeTrip(t *testing.T) {
+	trainTracker, _ := trackertest.NewTracker(t, trackertest.Config(), departureDay)
+	stations := trainTracker.Stations
+	first, last := stations[0], stations[len(stations)-1]
+
+	milestones := trainTracker.Milestones(first.DepartureTime, last.ArrivalTime)
+	counts := make(map[models.MilestoneKind]int)
+	for i, m := range milestones {
+		counts[m.Kind]++
+		if i > 0 && m.At.Before(milestones[i-1].At) {
+			t.Fatalf("milestone %d at %v is before the previous one", i, m.At)
+		}
+	}
+
+	if counts[models.MilestoneArrival] != len(stations)-1 || counts[models.MilestoneDeparture] != len(stations)-1 {
+		t.Errorf("expected %d arrivals and departures, got %v", len(stations)-1, counts)
+	}
+	if counts[models.MilestoneTimezone] != len(trainTracker.TimezoneBorders()) {
+		t.Errorf("expected %d borders, got %d", len(trainTracker.TimezoneBorders()), counts[models.MilestoneTimezone])
+	}
+	if counts[models.MilestoneDate] < 7 {
+		t.Errorf("a week-long trip must change date at least 7 times, got %d", counts[models.MilestoneDate])
+	}
+
+	if m := milestones[0]; m.Kind != models.MilestoneDeparture || m.Station != first.Name {
+		t.Errorf("trip must start with departure from %s, got %+v", first.Name, m)
+	}
+	if m := milestones[len(milestones)-1]; m.Kind != models.MilestoneArrival || m.Station != last.Name || m.Timezone != last.Timezone {
+		t.Errorf("trip must end with arrival at %s, got %+v", last.Name, m)
+	}
+}
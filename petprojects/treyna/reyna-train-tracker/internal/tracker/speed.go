@@ -0,0 +1,253 @@
+package tracker
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/models"
+)
+
+// Параметры модели скорости
+const (
+	paceWindow           = 5      // Сколько последних наблюдённых перегонов определяют темп
+	priorPaceSpread      = 0.05   // Разброс темпа без наблюдений: ±5% от планового времени
+	confidenceZ          = 1.2816 // Квантиль нормального распределения для интервала 80%
+	predictionConfidence = 0.8
+)
+
+// SpeedModel модель движения поезда: плановые скорости на перегонах,
+// поправленные на темп и опоздание, наблюдённые в журнале поездки
+type SpeedModel struct {
+	Segments     []models.SegmentSpeed // Перегон i: станция i -> станция i+1
+	Pace         float64               // Фактическое время в пути / плановое (1 - идёт по расписанию)
+	PaceSpread   float64               // Стандартное отклонение темпа
+	Delay        time.Duration         // Опоздание по последнему наблюдению
+	Observations int                   // Наблюдений (прибытий, отправлений, опозданий) до момента модели
+
+	stations []models.StationInfo
+}
+
+// BuildSpeedModel строит модель по расписанию и событиям журнала, произошедшим до момента at
+func BuildSpeedModel(stations []models.StationInfo, start time.Time, events []journal.Event, at time.Time) *SpeedModel {
+	m := &SpeedModel{
+		Pace:       1,
+		PaceSpread: priorPaceSpread,
+		stations:   stations,
+	}
+
+	for i := 0; i+1 < len(stations); i++ {
+		prev, next := stations[i], stations[i+1]
+		segment := models.SegmentSpeed{
+			From:       prev.Name,
+			To:         next.Name,
+			DistanceKm: next.DistanceFromStart - prev.DistanceFromStart,
+		}
+		if hours := next.ArrivalTime.Sub(prev.DepartureTime).Hours(); hours > 0 {
+			segment.PlannedSpeed = float64(segment.DistanceKm) / hours
+		}
+		m.Segments = append(m.Segments, segment)
+	}
+
+	var known []journal.Event
+	for _, e := range events {
+		if e.Type != journal.EventPositionQuery && !e.At.After(at) {
+			known = append(known, e)
+		}
+	}
+	m.Observations = len(known)
+	if len(known) == 0 {
+		return m
+	}
+
+	// Темп по наблюдённым перегонам; отчёт идёт в порядке маршрута,
+	// поэтому последние перегоны - самые свежие (скользящее окно)
+	var paces []float64
+	for _, segment := range journal.Compare(stations, start, known).Segments {
+		if segment.Planned <= 0 || segment.Actual <= 0 {
+			continue
+		}
+		if i := FindStationIndex(stations, segment.From.ID); i >= 0 && i < len(m.Segments) {
+			m.Segments[i].ObservedSpeed = float64(m.Segments[i].DistanceKm) / segment.Actual.Hours()
+		}
+		paces = append(paces, float64(segment.Actual)/float64(segment.Planned))
+	}
+	if len(paces) > paceWindow {
+		paces = paces[len(paces)-paceWindow:]
+	}
+	if len(paces) > 0 {
+		m.Pace, m.PaceSpread = meanAndSpread(paces)
+	}
+
+	// Опоздание - по самому позднему наблюдению
+	noPosition := func(time.Time) *models.CurrentPosition { return nil }
+	for _, step := range journal.Replay(stations, known, noPosition) {
+		m.Delay = step.Delay
+	}
+
+	return m
+}
+
+// PredictArrival прогноз прибытия на станцию с индексом index для момента at
+func (m *SpeedModel) PredictArrival(index int, at time.Time) models.ArrivalPrediction {
+	target := m.stations[index]
+	p := models.ArrivalPrediction{
+		Station:      target.Name,
+		Scheduled:    target.ArrivalTime,
+		Confidence:   predictionConfidence,
+		Observations: m.Observations,
+	}
+
+	// Прогноз выдаём в поясе расписания (московском), как и Scheduled,
+	// а не в поясе вызывающего: at может прийти в UTC
+	at = at.In(target.ArrivalTime.Location())
+
+	// Поезд с опозданием D сейчас там, где по расписанию был в момент at - D
+	remaining := target.ArrivalTime.Sub(at.Add(-m.Delay))
+	if remaining <= 0 {
+		// По нашим данным поезд уже должен был прибыть
+		p.Expected, p.Earliest, p.Latest = at, at, at
+	} else {
+		expected := time.Duration(float64(remaining) * m.Pace)
+		spread := time.Duration(confidenceZ * m.PaceSpread * float64(remaining))
+
+		p.Expected = at.Add(expected).Truncate(time.Second)
+		p.Latest = at.Add(expected + spread).Truncate(time.Second)
+		p.Earliest = at
+		if expected > spread {
+			p.Earliest = at.Add(expected - spread).Truncate(time.Second)
+		}
+	}
+
+	p.Delay = p.Expected.Sub(p.Scheduled)
+	return p
+}
+
+// speedModelCache модель скорости по всем наблюдениям журнала: строится при первом
+// прогнозе и сбрасывается записью в журнал, так что прогноз не перечитывает журнал
+type speedModelCache struct {
+	mu     sync.Mutex
+	model  *SpeedModel     // nil - построить заново
+	events []journal.Event // Наблюдения журнала, по которым построена модель
+	latest time.Time       // Момент последнего наблюдения
+}
+
+func (c *speedModelCache) invalidate() {
+	c.mu.Lock()
+	c.model, c.events, c.latest = nil, nil, time.Time{}
+	c.mu.Unlock()
+}
+
+// watchedJournal журнал, запись в который сбрасывает кэш модели скорости
+type watchedJournal struct {
+	journal.Recorder
+	speed *speedModelCache
+}
+
+func (j watchedJournal) Append(e journal.Event) (journal.Event, error) {
+	e, err := j.Recorder.Append(e)
+	// Запросы позиции модель не учитывает
+	if err == nil && e.Type != journal.EventPositionQuery {
+		j.speed.invalidate()
+	}
+	return e, err
+}
+
+// watchJournal подключает журнал к кэшу модели скорости
+func (t *TrainTracker) watchJournal(r journal.Recorder) journal.Recorder {
+	return watchedJournal{Recorder: r, speed: &t.speed}
+}
+
+// SpeedModel модель скорости на момент at с учётом журнала поездки.
+// Модель общая для всех запросов - не изменяйте её
+func (t *TrainTracker) SpeedModel(at time.Time) *SpeedModel {
+	c := &t.speed
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.model == nil {
+		events, err := t.observations()
+		if err != nil {
+			t.logf("⚠️  Не удалось прочитать журнал для прогноза: %v\n", err)
+			return BuildSpeedModel(t.Stations, t.RouteData.StartTime, events, at)
+		}
+		c.events = events
+		for _, e := range events {
+			if e.At.After(c.latest) {
+				c.latest = e.At
+			}
+		}
+		c.model = BuildSpeedModel(t.Stations, t.RouteData.StartTime, c.events, c.latest)
+	}
+
+	// Модель по всем наблюдениям верна для любого момента не раньше последнего из них;
+	// для прошлого строим модель только по тому, что было известно тогда
+	if !at.Before(c.latest) {
+		return c.model
+	}
+	return BuildSpeedModel(t.Stations, t.RouteData.StartTime, c.events, at)
+}
+
+// observations наблюдения журнала поездки без запросов позиции
+func (t *TrainTracker) observations() ([]journal.Event, error) {
+	if t.Journal == nil {
+		return nil, nil
+	}
+	events, err := t.Journal.Events()
+	if err != nil {
+		return nil, err
+	}
+	var observed []journal.Event
+	for _, e := range events {
+		if e.Type != journal.EventPositionQuery {
+			observed = append(observed, e)
+		}
+	}
+	return observed, nil
+}
+
+// PredictArrival прогноз прибытия на станцию stationID для момента at
+func (t *TrainTracker) PredictArrival(stationID int, at time.Time) (models.ArrivalPrediction, bool) {
+	index := FindStationIndex(t.Stations, stationID)
+	if index < 0 {
+		return models.ArrivalPrediction{}, false
+	}
+	return t.SpeedModel(at).PredictArrival(index, at), true
+}
+
+// RouteAverageSpeed средняя скорость по всему маршруту с учётом стоянок (км/ч).
+// 0, если у маршрута меньше двух станций
+func RouteAverageSpeed(stations []models.StationInfo) float64 {
+	if len(stations) < 2 {
+		return 0
+	}
+
+	first, last := stations[0], stations[len(stations)-1]
+	hours := last.ArrivalTime.Sub(first.DepartureTime).Hours()
+	if hours <= 0 {
+		return 0
+	}
+	return float64(last.DistanceFromStart-first.DistanceFromStart) / hours
+}
+
+// meanAndSpread среднее и стандартное отклонение темпа.
+// По одному наблюдению разброс не оценить - берём априорный
+func meanAndSpread(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	if len(values) < 2 {
+		return mean, priorPaceSpread
+	}
+
+	var squares float64
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	spread := math.Sqrt(squares / float64(len(values)-1))
+	return mean, math.Max(spread, priorPaceSpread)
+}
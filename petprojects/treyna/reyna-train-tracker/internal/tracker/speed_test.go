@@ -0,0 +1,169 @@
+package tracker_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+// TestPredictArrivalWithLoggedDelay отметка об опоздании сдвигает прогноз сразу,
+// а без новых записей модель берётся из памяти
+func TestPredictArrivalWithLoggedDelay(t *testing.T) {
+	cfg := trackertest.Config()
+	cfg.JournalEnabled = true
+	cfg.JournalDir = t.TempDir()
+	trainTracker, _ := trackertest.NewTracker(t, cfg, departureDay)
+
+	at := departureDay.Add(3 * time.Hour)
+	pos := trainTracker.GetCurrentPosition(at)
+	if pos == nil || pos.NextStation == nil {
+		t.Fatalf("no next station at %v", at)
+	}
+	if trainTracker.SpeedModel(at) != trainTracker.SpeedModel(at.Add(time.Hour)) {
+		t.Error("speed model must be cached between journal writes")
+	}
+
+	tests := []struct {
+		name  string
+		delay time.Duration
+	}{
+		{"on time", 0},
+		{"delayed", 30 * time.Minute},
+		{"delay updated", 45 * time.Minute},
+		{"caught up", 10 * time.Minute},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loggedAt := at.Add(time.Duration(i) * time.Minute)
+			if _, err := trainTracker.RecordEvent(journal.Event{Type: journal.EventDelay, At: loggedAt, Delay: tt.delay}); err != nil {
+				t.Fatal(err)
+			}
+			prediction, ok := trainTracker.PredictArrival(pos.NextStation.ID, loggedAt)
+			if !ok {
+				t.Fatal("no prediction")
+			}
+			if prediction.Delay != tt.delay || !prediction.Expected.Equal(pos.NextStation.ArrivalTime.Add(tt.delay)) {
+				t.Errorf("expected %v delay, got %+v", tt.delay, prediction)
+			}
+		})
+	}
+
+	// Прогноз для прошлого видит только то, что было известно тогда
+	if model := trainTracker.SpeedModel(at.Add(90 * time.Second)); model.Delay != 30*time.Minute || model.Observations != 2 {
+		t.Errorf("past model must ignore later observations: delay %v, observations %d", model.Delay, model.Observations)
+	}
+}
+
+func TestBuildSpeedModel(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	clock := func(hour, minute int) time.Time { return time.Date(2025, 10, 7, hour, minute, 0, 0, msk) }
+
+	// Четыре станции через 100 км, по часу на перегон, без стоянок
+	var stations []models.StationInfo
+	for i := range 4 {
+		at := clock(10+i, 0)
+		stations = append(stations, models.StationInfo{
+			ID:                i + 1,
+			Name:              fmt.Sprintf("Станция %d", i+1),
+			ArrivalTime:       at,
+			DepartureTime:     at,
+			DistanceFromStart: 100 * i,
+		})
+	}
+	event := func(typ journal.EventType, station, hour, minute int) journal.Event {
+		return journal.Event{Type: typ, StationID: station, At: clock(hour, minute)}
+	}
+
+	tests := []struct {
+		name         string
+		events       []journal.Event
+		at           time.Time
+		target       int
+		pace         float64
+		delay        time.Duration
+		observations int
+		expected     time.Time
+	}{
+		{
+			name:     "по расписанию",
+			at:       clock(10, 0),
+			target:   3,
+			pace:     1,
+			expected: clock(13, 0),
+		},
+		{
+			name: "медленный перегон",
+			events: []journal.Event{
+				event(journal.EventDeparture, 1, 10, 0),
+				event(journal.EventArrival, 2, 11, 12),
+			},
+			at:           clock(11, 12),
+			target:       3,
+			pace:         1.2,
+			delay:        12 * time.Minute,
+			observations: 2,
+			expected:     clock(13, 36),
+		},
+		{
+			name: "темп усредняется по перегонам",
+			events: []journal.Event{
+				event(journal.EventDeparture, 1, 10, 0),
+				event(journal.EventArrival, 2, 11, 12),
+				event(journal.EventDeparture, 2, 11, 12),
+				event(journal.EventArrival, 3, 12, 0),
+			},
+			at:           clock(12, 0),
+			target:       3,
+			pace:         1,
+			observations: 4,
+			expected:     clock(13, 0),
+		},
+		{
+			name: "будущие наблюдения и запросы позиции не учитываются",
+			events: []journal.Event{
+				event(journal.EventDeparture, 1, 10, 0),
+				event(journal.EventPositionQuery, 0, 10, 20),
+				event(journal.EventArrival, 2, 11, 12),
+			},
+			at:           clock(10, 30),
+			target:       3,
+			pace:         1,
+			observations: 1,
+			expected:     clock(13, 0),
+		},
+		{
+			name:         "поезд уже должен был прибыть",
+			events:       []journal.Event{{Type: journal.EventDelay, At: clock(11, 30), Delay: 20 * time.Minute}},
+			at:           clock(11, 30),
+			target:       1,
+			pace:         1,
+			delay:        20 * time.Minute,
+			observations: 1,
+			expected:     clock(11, 30),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := tracker.BuildSpeedModel(stations, clock(10, 0), tt.events, tt.at)
+			if math.Abs(model.Pace-tt.pace) > 1e-9 || model.Delay != tt.delay || model.Observations != tt.observations {
+				t.Errorf("model pace %.3f delay %v observations %d, want %.3f %v %d",
+					model.Pace, model.Delay, model.Observations, tt.pace, tt.delay, tt.observations)
+			}
+
+			prediction := model.PredictArrival(tt.target, tt.at)
+			if !prediction.Expected.Equal(tt.expected) {
+				t.Errorf("expected arrival %v, got %v", tt.expected, prediction.Expected)
+			}
+			if prediction.Earliest.After(prediction.Expected) || prediction.Latest.Before(prediction.Expected) {
+				t.Errorf("interval [%v, %v] does not contain %v", prediction.Earliest, prediction.Latest, prediction.Expected)
+			}
+		})
+	}
+}
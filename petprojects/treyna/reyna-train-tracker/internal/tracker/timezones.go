@@ -0,0 +1,122 @@
+package tracker
+
+import (
+	"fmt"
+	"time"
+
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/utils"
+)
+
+// DetectTimezoneBorders находит границы поясов на перегонах, где у соседних
+// станций разные пояса. Километр границы берётся из utils.TimezoneBoundaries,
+// если он лежит на этом перегоне, иначе - середина перегона
+func DetectTimezoneBorders(stations []models.StationInfo) []models.TimezoneBorder {
+	var borders []models.TimezoneBorder
+	for i := 0; i+1 < len(stations); i++ {
+		prev, next := stations[i], stations[i+1]
+		if prev.Timezone == next.Timezone {
+			continue
+		}
+
+		border := models.TimezoneBorder{
+			Km:          float64(prev.DistanceFromStart+next.DistanceFromStart) / 2,
+			From:        prev.Timezone,
+			To:          next.Timezone,
+			PrevStation: prev.Name,
+			NextStation: next.Name,
+			Estimated:   true,
+		}
+		for _, known := range utils.TimezoneBoundaries {
+			if known.From == prev.Timezone && known.To == next.Timezone &&
+				known.Km > prev.DistanceFromStart && known.Km < next.DistanceFromStart {
+				border.Km = float64(known.Km)
+				border.Estimated = false
+				break
+			}
+		}
+
+		// Поезд идёт по перегону равномерно (как в createBetweenPosition)
+		border.ETA = next.ArrivalTime
+		if span := next.DistanceFromStart - prev.DistanceFromStart; span > 0 {
+			progress := (border.Km - float64(prev.DistanceFromStart)) / float64(span)
+			travel := next.ArrivalTime.Sub(prev.DepartureTime)
+			border.ETA = prev.DepartureTime.Add(time.Duration(progress * float64(travel))).Truncate(time.Second)
+		}
+
+		borders = append(borders, border)
+	}
+	return borders
+}
+
+// TimezoneBorders границы поясов на маршруте в порядке следования
+func (t *TrainTracker) TimezoneBorders() []models.TimezoneBorder {
+	return t.timezoneBorders
+}
+
+// TimezoneAt часовой пояс пассажира в момент at с учётом границ на перегонах
+func (t *TrainTracker) TimezoneAt(at time.Time) string {
+	if len(t.Stations) == 0 {
+		return ""
+	}
+
+	tz := t.Stations[0].Timezone
+	for _, border := range t.timezoneBorders {
+		if border.ETA.After(at) {
+			break
+		}
+		tz = border.To
+	}
+	return tz
+}
+
+// UpcomingTimezoneChanges предстоящие переводы часов после момента at.
+// Границы между поясами с одинаковым смещением (Новосибирск - Красноярск) пропускаются
+func (t *TrainTracker) UpcomingTimezoneChanges(at time.Time) []models.TimezoneChange {
+	var distance float64
+	if pos := FindCurrentPositionTwoPointers(t.Stations, at); pos != nil {
+		distance = pos.DistanceFromStart
+	}
+
+	var changes []models.TimezoneChange
+	for _, border := range t.timezoneBorders {
+		if !border.ETA.After(at) {
+			continue
+		}
+
+		before, errFrom := utils.OffsetAt(border.From, border.ETA)
+		after, errTo := utils.OffsetAt(border.To, border.ETA)
+		if errFrom != nil || errTo != nil || before == after {
+			continue
+		}
+
+		ahead := border.Km - distance
+		if ahead < 0 {
+			ahead = 0
+		}
+
+		changes = append(changes, models.TimezoneChange{
+			Border:     border,
+			DistanceKm: ahead,
+			In:         border.ETA.Sub(at),
+			NewOffset:  after,
+			Shift:      after - before,
+		})
+	}
+	return changes
+}
+
+// buildIndexes строит хэш-таблицы станций и границы поясов после загрузки маршрута.
+// Заодно проверяет, что все пояса станций загружаются: без zoneinfo
+// ответы молча считались бы по неправильным часам
+func (t *TrainTracker) buildIndexes() error {
+	for _, station := range t.Stations {
+		if _, err := utils.LoadLocation(station.Timezone); err != nil {
+			return fmt.Errorf("station %s: %w", station.Name, err)
+		}
+	}
+
+	t.StationsByName, t.StationsByID = BuildStationHashMap(t.Stations)
+	t.timezoneBorders = DetectTimezoneBorders(t.Stations)
+	return nil
+}
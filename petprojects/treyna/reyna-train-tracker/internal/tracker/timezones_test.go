@@ -0,0 +1,136 @@
+package tracker_test
+
+import (
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+func TestDetectTimezoneBorders(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	departure := time.Date(2025, 10, 7, 12, 0, 0, 0, msk)
+	station := func(name, tz string, km int, arrival time.Time) models.StationInfo {
+		return models.StationInfo{Name: name, Timezone: tz, DistanceFromStart: km, ArrivalTime: arrival, DepartureTime: arrival}
+	}
+
+	tests := []struct {
+		name      string
+		next      models.StationInfo
+		wantNone  bool
+		km        float64
+		estimated bool
+		eta       time.Time
+	}{
+		{
+			name:     "один пояс",
+			next:     station("Зуевка", "Europe/Moscow", 1100, departure.Add(2*time.Hour)),
+			wantNone: true,
+		},
+		{
+			name: "известная граница на перегоне",
+			next: station("Глазов", "Asia/Yekaterinburg", 1100, departure.Add(2*time.Hour)),
+			km:   1070,
+			eta:  departure.Add(84 * time.Minute),
+		},
+		{
+			name:      "известная граница за перегоном",
+			next:      station("Глазов", "Asia/Yekaterinburg", 1060, departure.Add(2*time.Hour)),
+			km:        1030,
+			estimated: true,
+			eta:       departure.Add(time.Hour),
+		},
+		{
+			name:      "неизвестная граница - середина перегона",
+			next:      station("Омск", "Asia/Omsk", 1100, departure.Add(2*time.Hour)),
+			km:        1050,
+			estimated: true,
+			eta:       departure.Add(time.Hour),
+		},
+		{
+			name:      "нулевой перегон",
+			next:      station("Омск", "Asia/Omsk", 1000, departure.Add(2*time.Hour)),
+			km:        1000,
+			estimated: true,
+			eta:       departure.Add(2 * time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stations := []models.StationInfo{station("Киров", "Europe/Moscow", 1000, departure), tt.next}
+			borders := tracker.DetectTimezoneBorders(stations)
+			if tt.wantNone {
+				if len(borders) != 0 {
+					t.Fatalf("expected no borders, got %+v", borders)
+				}
+				return
+			}
+			if len(borders) != 1 {
+				t.Fatalf("expected one border, got %+v", borders)
+			}
+
+			border := borders[0]
+			if border.Km != tt.km || border.Estimated != tt.estimated || !border.ETA.Equal(tt.eta) {
+				t.Errorf("border = %+v, want km %.1f estimated %v eta %v", border, tt.km, tt.estimated, tt.eta)
+			}
+			if border.From != "Europe/Moscow" || border.To != tt.next.Timezone || border.PrevStation != "Киров" || border.NextStation != tt.next.Name {
+				t.Errorf("border endpoints = %+v", border)
+			}
+		})
+	}
+}
+
+func TestTimezoneAtBorders(t *testing.T) {
+	trainTracker, _ := trackertest.NewTracker(t, trackertest.Config(), departureDay)
+	borders := trainTracker.TimezoneBorders()
+	if len(borders) == 0 {
+		t.Fatal("route has no timezone borders")
+	}
+
+	if got := trainTracker.TimezoneAt(trainTracker.RouteData.StartTime); got != trainTracker.Stations[0].Timezone {
+		t.Errorf("timezone at departure = %s, want %s", got, trainTracker.Stations[0].Timezone)
+	}
+	for _, border := range borders {
+		if got := trainTracker.TimezoneAt(border.ETA.Add(-time.Second)); got != border.From {
+			t.Errorf("before border %s-%s: timezone %s", border.PrevStation, border.NextStation, got)
+		}
+		if got := trainTracker.TimezoneAt(border.ETA); got != border.To {
+			t.Errorf("at border %s-%s: timezone %s", border.PrevStation, border.NextStation, got)
+		}
+	}
+}
+
+func TestUpcomingTimezoneChanges(t *testing.T) {
+	trainTracker, _ := trackertest.NewTracker(t, trackertest.Config(), departureDay)
+	start := trainTracker.RouteData.StartTime
+
+	changes := trainTracker.UpcomingTimezoneChanges(start)
+	// Граница Новосибирск - Красноярск часы не переводит
+	if len(changes) != len(trainTracker.TimezoneBorders())-1 {
+		t.Fatalf("expected %d clock changes, got %d", len(trainTracker.TimezoneBorders())-1, len(changes))
+	}
+	for _, change := range changes {
+		if change.Border.From == "Asia/Novosibirsk" {
+			t.Errorf("Novosibirsk-Krasnoyarsk border reported as a clock change")
+		}
+		if change.Shift != time.Hour && !(change.Border.From == "Europe/Moscow" && change.Shift == 2*time.Hour) {
+			t.Errorf("border %s-%s: shift %v", change.Border.From, change.Border.To, change.Shift)
+		}
+		if change.In != change.Border.ETA.Sub(start) {
+			t.Errorf("border %s-%s: in %v, want %v", change.Border.From, change.Border.To, change.In, change.Border.ETA.Sub(start))
+		}
+	}
+
+	first := changes[0]
+	if first.NewOffset != 5*time.Hour || first.DistanceKm != first.Border.Km {
+		t.Errorf("first change = %+v, want UTC+5 at km %.0f", first, first.Border.Km)
+	}
+
+	last := changes[len(changes)-1]
+	if got := trainTracker.UpcomingTimezoneChanges(last.Border.ETA); len(got) != 0 {
+		t.Errorf("expected no changes after the last border, got %+v", got)
+	}
+}
@@ -1,17 +1,25 @@
 package tracker
 
 import (
+	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"reyna-train-tracker/internal/cache"
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/journal"
 	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/storage"
 	"reyna-train-tracker/internal/utils"
 )
 
@@ -21,28 +29,236 @@ type TrainTracker struct {
 	StationsByName   map[string]*models.StationInfo // Hash table для быстрого доступа
 	StationsByID     map[int]*models.StationInfo    // Hash table для быстрого доступа
 	RouteData        models.RouteData
-	Cache            *cache.InMemoryCache[interface{}] // In-memory cache с generic типом
+	Cache            cache.StatsCache[interface{}]     // In-memory cache с generic типом (обычный или шардированный)
 	RequestCounter   atomic.Uint64                     // Atomic counter для статистики запросов
-	QuestionCounters [11]atomic.Uint64                 // Счётчики для каждого из 10 вопросов (индекс 0 не используется)
+	Store            *storage.Store                    // Встроенная база (nil - расписание из JSON)
+	Journal          journal.Recorder                  // Журнал наблюдений поездки (nil - не ведётся)
+	TripID           string                            // Поездка, в журнал которой пишутся события
+	Clock            clock.Clock                       // Часы трекера: системные, фальшивые или симуляция
+	Output           io.Writer                         // Отчёт о загрузке и предупреждения (TRACKER_REPORT)
+
+	timezoneBorders  []models.TimezoneBorder // Границы поясов на перегонах (по ходу поезда)
+	speed            speedModelCache         // Модель скорости по журналу; сбрасывается при записи в журнал
+
+	questionMu       sync.RWMutex
+	questionCounters map[int]*atomic.Uint64 // Счётчики по номеру вопроса
+}
+
+func init() {
+	// Типы значений кэша трекера для снимков на диске (gob)
+	gob.Register(&models.CurrentPosition{})
 }
 
-// NewTrainTracker создаёт новый трекер
+// defaultCacheMaxEntries лимит кэша трекера, если конфигурация не задана
+const defaultCacheMaxEntries = 1000
+
+// NewTrainTracker создаёт новый трекер с LRU кэшем по умолчанию
 func NewTrainTracker(jsonPath string) (*TrainTracker, error) {
+	return newTrainTracker(jsonPath, cache.NewInMemoryCacheWithOptions(cache.Options[interface{}]{
+		Capacity: defaultCacheMaxEntries,
+	}), os.Stdout)
+}
+
+// ReportOutput куда трекер пишет отчёт о загрузке расписания и предупреждения:
+// stdout (по умолчанию), stderr - чтобы stdout остался для вывода команды, off - никуда
+func ReportOutput(name string) (io.Writer, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "stdout":
+		return os.Stdout, nil
+	case "stderr":
+		return os.Stderr, nil
+	case "off", "none":
+		return io.Discard, nil
+	}
+	return nil, fmt.Errorf("unknown tracker report output: %q (expected stdout, stderr or off)", name)
+}
+
+// NewTrainTrackerWithConfig создаёт трекер с размером кэша, политикой вытеснения
+// и количеством шардов из конфигурации; часы - по CLOCK_START и CLOCK_SPEED
+func NewTrainTrackerWithConfig(cfg *config.Config) (*TrainTracker, error) {
+	return NewTrainTrackerWithClock(cfg, clock.New(cfg.ClockStart, cfg.ClockSpeed))
+}
+
+// NewTrainTrackerWithClock как NewTrainTrackerWithConfig, но с заданными часами.
+// Одни часы на трекер и его кэш: TTL в симуляции идёт по времени симуляции
+func NewTrainTrackerWithClock(cfg *config.Config, clk clock.Clock) (*TrainTracker, error) {
+	out, err := ReportOutput(cfg.TrackerReport)
+	if err != nil {
+		return nil, err
+	}
+	newPolicy, err := cache.PolicyFactory(cfg.CacheEvictionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := cache.Options[interface{}]{Capacity: cfg.CacheMaxEntries, Clock: clk}
+
+	// Один шард - обычный кэш с одним RWMutex
+	var c cache.Snapshotter[interface{}]
+	if cfg.CacheShards <= 1 {
+		opts.Policy = newPolicy(cfg.CacheMaxEntries)
+		c = cache.NewInMemoryCacheWithOptions(opts)
+	} else {
+		c = cache.NewShardedCache(cache.ShardedOptions[interface{}]{
+			Options:   opts,
+			Shards:    cfg.CacheShards,
+			NewPolicy: newPolicy,
+		})
+	}
+
+	var trackerCache cache.StatsCache[interface{}] = c
+	if cfg.CacheSnapshotPath != "" {
+		// Кэш со снимком на диске переживает перезапуск трекера
+		persistent, restored, err := cache.NewFileBackedCache(c, cfg.CacheSnapshotPath, cfg.CacheSnapshotInterval)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		if restored > 0 {
+			fmt.Fprintf(out, "💾 Восстановлено из снимка кэша: %d записей\n", restored)
+		}
+		trackerCache = persistent
+	}
+
+	var t *TrainTracker
+	if cfg.StoragePath != "" {
+		t, err = newTrainTrackerFromStorage(cfg.StoragePath, cfg.RouteID, trackerCache, out)
+	} else {
+		t, err = newTrainTracker(cfg.JSONDataPath, trackerCache, out)
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.Clock = clk
+
+	if cfg.JournalEnabled {
+		if err := t.openJournal(cfg); err != nil {
+			t.Close()
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// newTrainTrackerFromStorage загружает маршрут routeID из встроенной базы
+// (заполняется миграцией cmd/migrate)
+func newTrainTrackerFromStorage(path, routeID string, c cache.StatsCache[interface{}], out io.Writer) (*TrainTracker, error) {
+	tracker := &TrainTracker{
+		Cache:  c,
+		Clock:  clock.Real(),
+		Output: out,
+	}
+
+	store, err := storage.Open(path)
+	if err != nil {
+		tracker.Close()
+		return nil, err
+	}
+	tracker.Store = store
+
+	route, err := store.Route(routeID)
+	if err == nil {
+		tracker.Stations, err = store.Stations(routeID)
+	}
+	if err != nil {
+		tracker.Close()
+		return nil, fmt.Errorf("failed to load route from storage (run cmd/migrate first?): %w", err)
+	}
+
+	tracker.RouteData = models.RouteData{
+		Name:          route.Name,
+		StartTime:     route.StartTime,
+		TotalDistance: route.TotalDistance,
+	}
+	if err := tracker.buildIndexes(); err != nil {
+		tracker.Close()
+		return nil, err
+	}
+
+	return tracker, nil
+}
+
+// openJournal открывает журнал поездки: в базе, если она подключена,
+// иначе в каталоге JOURNAL_DIR. Без того и другого журнал не ведётся
+func (t *TrainTracker) openJournal(cfg *config.Config) error {
+	// По умолчанию поездка называется датой отправления
+	tripID := cfg.TripID
+	if tripID == "" {
+		tripID = t.RouteData.StartTime.Format("2006-01-02")
+	}
+
+	switch {
+	case t.Store != nil:
+		_, err := t.Store.EnsureTrip(storage.Trip{
+			ID:        tripID,
+			RouteID:   cfg.RouteID,
+			StartTime: t.RouteData.StartTime,
+		})
+		if err != nil {
+			return err
+		}
+		t.Journal = t.watchJournal(t.Store.TripLog(tripID))
+	case cfg.JournalDir != "":
+		fileLog, err := journal.Open(cfg.JournalDir, tripID)
+		if err != nil {
+			return err
+		}
+		t.Journal = t.watchJournal(fileLog)
+	default:
+		return nil
+	}
+
+	t.TripID = tripID
+	return nil
+}
+
+func newTrainTracker(jsonPath string, c cache.StatsCache[interface{}], out io.Writer) (*TrainTracker, error) {
 	tracker := &TrainTracker{
-		Cache: cache.NewInMemoryCache[interface{}](),
+		Cache:  c,
+		Clock:  clock.Real(),
+		Output: out,
 	}
 
 	err := tracker.LoadSchedule(jsonPath)
 	if err != nil {
+		tracker.Close()
 		return nil, err
 	}
 
-	// Строим hash tables для быстрого доступа
-	tracker.StationsByName, tracker.StationsByID = BuildStationHashMap(tracker.Stations)
+	// Строим hash tables для быстрого доступа и границы поясов
+	if err := tracker.buildIndexes(); err != nil {
+		tracker.Close()
+		return nil, err
+	}
 
 	return tracker, nil
 }
 
+// Close освобождает фоновые ресурсы трекера (горутину очистки кэша, журнал, базу)
+func (t *TrainTracker) Close() {
+	t.Cache.Close()
+	if t.Journal != nil {
+		if err := t.Journal.Close(); err != nil {
+			t.logf("⚠️  Не удалось закрыть журнал поездки: %v\n", err)
+		}
+	}
+	if t.Store != nil {
+		if err := t.Store.Close(); err != nil {
+			t.logf("⚠️  Не удалось закрыть базу: %v\n", err)
+		}
+	}
+}
+
+// logf пишет в отчёт трекера (Output; без него - в stdout)
+func (t *TrainTracker) logf(format string, args ...interface{}) {
+	out := t.Output
+	if out == nil {
+		out = os.Stdout
+	}
+	fmt.Fprintf(out, format, args...)
+}
+
 // LoadSchedule загружает расписание из JSON файла
 // func (t *TrainTracker) LoadSchedule(jsonPath string) error {
 // 	data, err := os.ReadFile(jsonPath)
@@ -168,7 +384,10 @@ func (t *TrainTracker) LoadSchedule(jsonPath string) error {
 	}
 
 	// Преобразуем в StationInfo с полной информацией
-	moscowTZ, _ := time.LoadLocation("Europe/Moscow")
+	moscowTZ, err := utils.LoadLocation("Europe/Moscow")
+	if err != nil {
+		return err
+	}
 	currentDate := time.Date(2025, 10, 6, 0, 0, 0, 0, moscowTZ) // Начинаем с 6 октября
 
 	t.RouteData = models.RouteData{
@@ -183,7 +402,7 @@ func (t *TrainTracker) LoadSchedule(jsonPath string) error {
 	}
 	sort.Strings(sortedKeys)
 
-	fmt.Printf("🔍 КОРРЕКТИРОВАННАЯ ЗАГРУЗКА С 6 ПО 13 ОКТЯБРЯ:\n")
+	t.logf("🔍 КОРРЕКТИРОВАННАЯ ЗАГРУЗКА С 6 ПО 13 ОКТЯБРЯ:\n")
 
 	// Обрабатываем станции по порядку
 	for _, key := range sortedKeys {
@@ -219,14 +438,14 @@ func (t *TrainTracker) LoadSchedule(jsonPath string) error {
 		}
 
 		if departureTime.Before(arrivalTime) {
-			fmt.Printf("⚠️  ИСПРАВЛЕНО: %s - отправление раньше прибытия\n", station.Name)
+			t.logf("⚠️  ИСПРАВЛЕНО: %s - отправление раньше прибытия\n", station.Name)
 			departureTime = arrivalTime.Add(5 * time.Minute) // Минимальная стоянка 5 минут
 		}
 
 		// КОРРЕКТНАЯ ЛОГИКА: добавляем день только если время меньше предыдущего
 		// (это означает переход через полночь)
-		if stationID > 1 {
-			prevStation := t.Stations[stationID-2] // предыдущая станция
+		if len(t.Stations) > 0 {
+			prevStation := t.Stations[len(t.Stations)-1] // предыдущая добавленная станция
 			
 			// Если прибытие раньше отправления предыдущей станции - следующий день
 			if arrivalTime.Before(prevStation.DepartureTime) {
@@ -254,7 +473,7 @@ func (t *TrainTracker) LoadSchedule(jsonPath string) error {
 		t.Stations = append(t.Stations, stationInfo)
 		
 		// Выводим ВСЕ станции для проверки
-		fmt.Printf("🚉 %2d: %-30s | %s - %s | %s\n", 
+		t.logf("🚉 %2d: %-30s | %s - %s | %s\n", 
 			stationID, station.Name,
 			arrivalTime.Format("15:04 02.01"),
 			departureTime.Format("15:04 02.01"),
@@ -264,7 +483,7 @@ func (t *TrainTracker) LoadSchedule(jsonPath string) error {
 	// Проверяем дату прибытия в Хабаровск
 	if len(t.Stations) > 0 {
 		lastStation := t.Stations[len(t.Stations)-1]
-		fmt.Printf("\n📅 ПРИБЫТИЕ В ХАБАРОВСК: %s\n", 
+		t.logf("\n📅 ПРИБЫТИЕ В ХАБАРОВСК: %s\n", 
 			lastStation.ArrivalTime.Format("15:04 02.01.2006"))
 		
 		t.RouteData.TotalDistance = lastStation.DistanceFromStart
@@ -273,22 +492,6 @@ func (t *TrainTracker) LoadSchedule(jsonPath string) error {
 	return nil
 }
 
-// DebugAllStations отладочная функция для вывода всех станций
-func (t *TrainTracker) DebugAllStations() {
-	fmt.Printf("\n🔍 DEBUG ALL STATIONS TIMELINE:\n")
-	for i, station := range t.Stations {
-		if i < 10 || i > len(t.Stations)-10 { // Show first and last 10 stations
-			fmt.Printf("Station %2d: %-30s | Arr: %s | Dep: %s | Dist: %dkm\n",
-				station.ID, station.Name,
-				station.ArrivalTime.Format("15:04 02.01"),
-				station.DepartureTime.Format("15:04 02.01"),
-				station.DistanceFromStart)
-		} else if i == 10 {
-			fmt.Printf("... (middle stations omitted)\n")
-		}
-	}
-}
-
 // isMajorCity определяет, является ли город крупным
 func isMajorCity(name string) bool {
 	majorCities := []string{
@@ -330,33 +533,105 @@ func (t *TrainTracker) GetStationByID(id int) (*models.StationInfo, bool) {
 	return station, ok
 }
 
+// positionCacheBucket шаг, с которым позиции кэшируются.
+// Расписание задано с точностью до минуты, поэтому все запросы
+// внутри одной минуты получают одну и ту же вычисленную позицию
+const positionCacheBucket = time.Minute
+
+// errPositionNotFound позиция не найдена (не кэшируется)
+var errPositionNotFound = errors.New("position not found")
+
+// Now текущий момент по часам трекера
+func (t *TrainTracker) Now() time.Time {
+	return t.Clock.Now()
+}
+
 // GetCurrentPosition получает текущую позицию пассажира
-// Использует алгоритм двух указателей и кэш
+// Использует алгоритм двух указателей и кэш (cache-aside с объединением
+// одновременных промахов: позицию для минуты считает только один запрос)
 func (t *TrainTracker) GetCurrentPosition(currentTime time.Time) *models.CurrentPosition {
 	// Увеличиваем счётчик запросов (atomic operation)
 	t.RequestCounter.Add(1)
 
-	// Проверяем кэш
-	cacheKey := fmt.Sprintf("position_%d", currentTime.Unix())
-	if cached, ok := t.Cache.Get(cacheKey); ok {
-		if pos, ok := cached.(*models.CurrentPosition); ok {
-			return pos
+	bucket := currentTime.Truncate(positionCacheBucket)
+	cacheKey := fmt.Sprintf("position_%d", bucket.Unix())
+
+	cached, err := t.Cache.GetOrLoad(cacheKey, 1*time.Minute, func() (interface{}, error) {
+		// Используем алгоритм двух указателей
+		pos := FindCurrentPositionTwoPointers(t.Stations, bucket)
+		if pos == nil {
+			return nil, errPositionNotFound
 		}
+		// Между станциями пояс меняется на границе, а не на следующей станции
+		pos.Timezone = t.TimezoneAt(bucket)
+		// В журнал - один раз на минуту расчёта, а не на каждое попадание в кэш
+		t.recordPosition(bucket, pos)
+		return pos, nil
+	})
+	if err != nil {
+		return nil
+	}
+
+	cachedPos, ok := cached.(*models.CurrentPosition)
+	if !ok {
+		return nil
 	}
 
-	// Используем алгоритм двух указателей
-	pos := FindCurrentPositionTwoPointers(t.Stations, currentTime)
+	// Копия, чтобы локальное время соответствовало именно этому запросу
+	pos := *cachedPos
+	pos.LocalTime, _ = utils.ConvertToTimezone(currentTime, pos.Timezone)
+
+	return &pos
+}
 
-	if pos != nil {
-		// Конвертируем время в локальный часовой пояс
-		localTime, _ := utils.ConvertToTimezone(currentTime, pos.Timezone)
-		pos.LocalTime = localTime
+// ErrJournalDisabled журнал поездки не настроен (JOURNAL_DIR)
+var ErrJournalDisabled = errors.New("journal is disabled")
 
-		// Кэшируем результат на 1 минуту
-		t.Cache.Set(cacheKey, pos, 1*time.Minute)
+// RecordEvent записывает наблюдение в журнал поездки.
+// Станцию можно указать по ID или по названию - второе поле заполнится само
+func (t *TrainTracker) RecordEvent(e journal.Event) (journal.Event, error) {
+	if t.Journal == nil {
+		return journal.Event{}, ErrJournalDisabled
 	}
 
-	return pos
+	switch {
+	case e.StationID != 0:
+		station, ok := t.GetStationByID(e.StationID)
+		if !ok {
+			return journal.Event{}, fmt.Errorf("station %d not found", e.StationID)
+		}
+		e.Station = station.Name
+	case e.Station != "":
+		station, ok := t.GetStationByName(e.Station)
+		if !ok {
+			return journal.Event{}, fmt.Errorf("station %q not found", e.Station)
+		}
+		e.StationID = station.ID
+	}
+
+	return t.Journal.Append(e)
+}
+
+// recordPosition записывает в журнал, что трекер ответил на запрос позиции.
+// at - начало минуты, для которой посчитана позиция
+func (t *TrainTracker) recordPosition(at time.Time, pos *models.CurrentPosition) {
+	if t.Journal == nil {
+		return
+	}
+
+	e := journal.Event{
+		Type:       journal.EventPositionQuery,
+		At:         at,
+		DistanceKm: pos.DistanceFromStart,
+	}
+	if pos.IsAtStation && pos.CurrentStation != nil {
+		e.StationID = pos.CurrentStation.ID
+		e.Station = pos.CurrentStation.Name
+	}
+
+	if _, err := t.Journal.Append(e); err != nil {
+		t.logf("⚠️  Не удалось записать событие в журнал: %v\n", err)
+	}
 }
 
 // GetTrainStatus получает статус поезда (стоит или едет)
@@ -396,14 +671,52 @@ func (t *TrainTracker) GetJourneyInfo(currentTime time.Time) models.JourneyInfo
 	days := int(info.TotalTimeInTrip.Hours() / 24)
 	info.DayNumber = days + 1
 
+	if len(t.Stations) == 0 {
+		return info
+	}
+
+	// Пассажир считает дни по местным полуночам, а не по 24 часа от отправления
+	info.Timezone = t.TimezoneAt(currentTime)
+	info.LocalDate = localDate(currentTime, info.Timezone)
+	info.CalendarDay = CalendarDay(t.RouteData.StartTime, t.Stations[0].Timezone, currentTime, info.Timezone)
+
+	arrival := t.Stations[len(t.Stations)-1].ArrivalTime
+	info.DateChanges = t.LocalDateChanges(currentTime, arrival)
+
 	return info
 }
 
 // IncrementQuestionCounter увеличивает счётчик для конкретного вопроса
 func (t *TrainTracker) IncrementQuestionCounter(questionNumber int) {
-	if questionNumber >= 1 && questionNumber <= 10 {
-		t.QuestionCounters[questionNumber].Add(1)
+	t.questionMu.RLock()
+	counter, ok := t.questionCounters[questionNumber]
+	t.questionMu.RUnlock()
+
+	if !ok {
+		t.questionMu.Lock()
+		if t.questionCounters == nil {
+			t.questionCounters = make(map[int]*atomic.Uint64)
+		}
+		counter, ok = t.questionCounters[questionNumber]
+		if !ok {
+			counter = &atomic.Uint64{}
+			t.questionCounters[questionNumber] = counter
+		}
+		t.questionMu.Unlock()
+	}
+
+	counter.Add(1)
+}
+
+// QuestionCount возвращает, сколько раз задавали вопрос
+func (t *TrainTracker) QuestionCount(questionNumber int) uint64 {
+	t.questionMu.RLock()
+	defer t.questionMu.RUnlock()
+
+	if counter, ok := t.questionCounters[questionNumber]; ok {
+		return counter.Load()
 	}
+	return 0
 }
 
 // GetStatistics возвращает статистику использования
@@ -412,11 +725,17 @@ func (t *TrainTracker) GetStatistics() map[string]interface{} {
 	stats["total_requests"] = t.RequestCounter.Load()
 
 	questionStats := make(map[string]uint64)
-	for i := 1; i <= 10; i++ {
-		questionStats[fmt.Sprintf("question_%d", i)] = t.QuestionCounters[i].Load()
+	t.questionMu.RLock()
+	for id, counter := range t.questionCounters {
+		questionStats[fmt.Sprintf("question_%d", id)] = counter.Load()
 	}
+	t.questionMu.RUnlock()
 	stats["question_counters"] = questionStats
-	stats["cache_size"] = t.Cache.Size()
+	cacheStats := t.Cache.Stats()
+	stats["cache_size"] = cacheStats.Entries
+	stats["cache_capacity"] = cacheStats.Capacity
+	stats["cache_evictions"] = cacheStats.Evictions
+	stats["cache_expirations"] = cacheStats.Expirations
 
 	return stats
 }
@@ -0,0 +1,76 @@
+package tracker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/tracker"
+	"reyna-train-tracker/internal/tracker/trackertest"
+)
+
+var departureDay = time.Date(2025, 10, 12, 20, 30, 0, 0, time.FixedZone("MSK", 3*3600))
+
+func TestPositionQueriesJournaledOncePerMinute(t *testing.T) {
+	cfg := trackertest.Config()
+	cfg.JournalEnabled = true
+	cfg.JournalDir = t.TempDir()
+	trainTracker, _ := trackertest.NewTracker(t, cfg, departureDay)
+
+	queries := []time.Duration{0, 0, 10 * time.Second, 59 * time.Second, time.Minute, time.Minute + 30*time.Second}
+	for _, offset := range queries {
+		if trainTracker.GetCurrentPosition(departureDay.Add(offset)) == nil {
+			t.Fatalf("no position at +%v", offset)
+		}
+	}
+
+	events, err := trainTracker.Journal.Events()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var logged []time.Time
+	for _, e := range events {
+		if e.Type == journal.EventPositionQuery {
+			logged = append(logged, e.At)
+		}
+	}
+	if len(logged) != 2 || !logged[0].Equal(departureDay) || !logged[1].Equal(departureDay.Add(time.Minute)) {
+		t.Errorf("expected one position_query per minute, got %v", logged)
+	}
+}
+
+func TestTrackerReportOutput(t *testing.T) {
+	tests := []struct {
+		report string
+		want   io.Writer
+	}{
+		{"stdout", os.Stdout},
+		{"", os.Stdout},
+		{"stderr", os.Stderr},
+		{"off", io.Discard},
+		{"syslog", nil},
+	}
+
+	for _, tt := range tests {
+		cfg := trackertest.Config()
+		cfg.TrackerReport = tt.report
+		trainTracker, err := tracker.NewTrainTrackerWithClock(cfg, clock.NewFake(departureDay))
+		if tt.want == nil {
+			if err == nil {
+				trainTracker.Close()
+				t.Errorf("report %q: expected an error", tt.report)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("report %q: %v", tt.report, err)
+		}
+		if trainTracker.Output != tt.want {
+			t.Errorf("report %q: output %v, want %v", tt.report, trainTracker.Output, tt.want)
+		}
+		trainTracker.Close()
+	}
+}
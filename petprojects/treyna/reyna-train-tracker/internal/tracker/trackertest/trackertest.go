@@ -0,0 +1,72 @@
+// Package trackertest общая обвязка тестов: трекер с маршрутом из репозитория
+// на фальшивых часах и обработчик вопросов к нему
+package trackertest
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"reyna-train-tracker/internal/api"
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/config"
+	"reyna-train-tracker/internal/metrics"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// RoutePath путь к reyna_route.json в корне модуля - не зависит от каталога теста
+func RoutePath() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "reyna_route.json")
+}
+
+// Config конфигурация тестов. Собрана явно, без LoadConfig: переменные окружения
+// на тесты не влияют. Журнал, база, снимки кэша и отчёт о загрузке выключены
+func Config() *config.Config {
+	return &config.Config{
+		MaxConcurrentRequests: 10,
+		RateLimitPerSecond:    100,
+		CacheTTL:              5 * time.Minute,
+		CacheMaxEntries:       1000,
+		CacheEvictionPolicy:   "lru",
+		CacheShards:           16,
+		NumWorkers:            5,
+		LoadBalancerStrategy:  "round-robin",
+		QueueSize:             100,
+		MaxRetries:            3,
+		JSONDataPath:          RoutePath(),
+		RouteID:               "moscow-khabarovsk",
+		ClockSpeed:            1,
+		Language:              "ru",
+		TrackerReport:         "off",
+		BotPollTimeout:        time.Second,
+		BotPushEvents:         []string{"arrival", "timezone", "date"},
+		BotPushMajorOnly:      true,
+	}
+}
+
+// NewTracker трекер по cfg на фальшивых часах, выставленных на at.
+// Закрывается по окончании теста
+func NewTracker(t testing.TB, cfg *config.Config, at time.Time) (*tracker.TrainTracker, *clock.Fake) {
+	t.Helper()
+
+	clk := clock.NewFake(at)
+	trainTracker, err := tracker.NewTrainTrackerWithClock(cfg, clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(trainTracker.Close)
+	return trainTracker, clk
+}
+
+// New трекер и обработчик вопросов по Config() на фальшивых часах
+func New(t testing.TB, at time.Time) (*tracker.TrainTracker, *api.QuestionHandler) {
+	t.Helper()
+
+	cfg := Config()
+	trainTracker, _ := NewTracker(t, cfg, at)
+	handler := api.NewQuestionHandlerWithConfig(trainTracker, cfg, metrics.NewMetricsCollector())
+	t.Cleanup(func() { handler.Close() })
+	return trainTracker, handler
+}
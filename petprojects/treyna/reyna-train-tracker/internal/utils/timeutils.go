@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -111,6 +112,21 @@ var TimezoneMap = map[string]string{
 	"Хабаровск 1":    "Asia/Vladivostok",
 }
 
+// TimezoneBoundary граница часовых поясов на железной дороге
+type TimezoneBoundary struct {
+	Km   int    // Приблизительный километр от Москвы (в шкале DistanceMap)
+	From string // Пояс до границы (по ходу поезда)
+	To   string // Пояс после границы
+}
+
+// TimezoneBoundaries известные границы поясов на маршруте (по границам регионов).
+// Для остальных перегонов граница оценивается по середине перегона
+var TimezoneBoundaries = []TimezoneBoundary{
+	{Km: 1070, From: "Europe/Moscow", To: "Asia/Yekaterinburg"}, // Кировская область - Удмуртия
+	{Km: 2490, From: "Asia/Yekaterinburg", To: "Asia/Omsk"},     // Тюменская - Омская область
+	{Km: 8620, From: "Asia/Yakutsk", To: "Asia/Vladivostok"},    // Амурская область - ЕАО
+}
+
 // GetTimezone получает часовой пояс для города
 func GetTimezone(cityName string) string {
 	if tz, ok := TimezoneMap[cityName]; ok {
@@ -165,38 +181,63 @@ func ParseTime(timeStr string, date time.Time) (time.Time, error) {
 	return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, date.Location()), nil
 }
 
+// locations кэш загруженных часовых поясов: time.LoadLocation каждый раз читает zoneinfo
+var locations sync.Map // string -> locationEntry
+
+type locationEntry struct {
+	loc *time.Location
+	err error
+}
+
+// LoadLocation загружает часовой пояс с кэшированием (ошибки тоже кэшируются)
+func LoadLocation(timezone string) (*time.Location, error) {
+	if cached, ok := locations.Load(timezone); ok {
+		entry := cached.(locationEntry)
+		return entry.loc, entry.err
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		err = fmt.Errorf("unknown timezone %q (install tzdata or build with -tags tzdata): %w", timezone, err)
+	}
+	locations.Store(timezone, locationEntry{loc: loc, err: err})
+	return loc, err
+}
+
 // ConvertToTimezone конвертирует время в указанный часовой пояс
 func ConvertToTimezone(t time.Time, timezone string) (time.Time, error) {
-	loc, err := time.LoadLocation(timezone)
+	loc, err := LoadLocation(timezone)
 	if err != nil {
 		return time.Time{}, err
 	}
 	return t.In(loc), nil
 }
 
-// GetTimezoneDifference получает разницу во времени между двумя часовыми поясами
-func GetTimezoneDifference(tz1, tz2 string) (time.Duration, error) {
-	now := time.Now()
-
-	loc1, err := time.LoadLocation(tz1)
+// OffsetAt смещение пояса от UTC в момент at (с учётом исторических правил и летнего времени)
+func OffsetAt(timezone string, at time.Time) (time.Duration, error) {
+	loc, err := LoadLocation(timezone)
 	if err != nil {
 		return 0, err
 	}
+	_, offset := at.In(loc).Zone()
+	return time.Duration(offset) * time.Second, nil
+}
 
-	loc2, err := time.LoadLocation(tz2)
+// GetTimezoneDifference получает разницу во времени между двумя часовыми поясами
+// в момент at: правила поясов менялись (Россия отменяла и возвращала перевод часов),
+// поэтому для прошлых и будущих моментов нельзя брать сегодняшние смещения
+func GetTimezoneDifference(tz1, tz2 string, at time.Time) (time.Duration, error) {
+	offset1, err := OffsetAt(tz1, at)
 	if err != nil {
 		return 0, err
 	}
 
-	t1 := now.In(loc1)
-	t2 := now.In(loc2)
-
-	_, offset1 := t1.Zone()
-	_, offset2 := t2.Zone()
-
-	diff := time.Duration(offset2-offset1) * time.Second
+	offset2, err := OffsetAt(tz2, at)
+	if err != nil {
+		return 0, err
+	}
 
-	return diff, nil
+	return offset2 - offset1, nil
 }
 
 // FormatDuration форматирует длительность в читаемый вид
@@ -216,42 +257,28 @@ func FormatDuration(d time.Duration) string {
 
 // EnhancedTimeConversion улучшенная конвертация времени
 func EnhancedTimeConversion(t time.Time, fromTZ, toTZ string) (time.Time, error) {
-	
-	toLoc, err := time.LoadLocation(toTZ)
+	toLoc, err := LoadLocation(toTZ)
 	if err != nil {
 		return time.Time{}, err
 	}
-	
+
 	// Конвертируем через UTC для избежания ошибок
 	utcTime := t.In(time.UTC)
 	return utcTime.In(toLoc), nil
 }
 
-// CalculateExactTimeDifference точный расчёт разницы во времени
+// CalculateExactTimeDifference то же, что GetTimezoneDifference (оставлено для совместимости)
 func CalculateExactTimeDifference(tz1, tz2 string, referenceTime time.Time) (time.Duration, error) {
-	loc1, err := time.LoadLocation(tz1)
-	if err != nil {
-		return 0, err
-	}
-	
-	loc2, err := time.LoadLocation(tz2)
-	if err != nil {
-		return 0, err
-	}
-	
-	t1 := referenceTime.In(loc1)
-	t2 := referenceTime.In(loc2)
-	
-	return t2.Sub(t1), nil
+	return GetTimezoneDifference(tz1, tz2, referenceTime)
 }
 
 // FormatTimeWithTimezone форматирует время с указанием часового пояса
 func FormatTimeWithTimezone(t time.Time, timezone string) (string, error) {
-	loc, err := time.LoadLocation(timezone)
+	loc, err := LoadLocation(timezone)
 	if err != nil {
 		return "", err
 	}
-	
+
 	localTime := t.In(loc)
 	return localTime.Format("15:04 02.01.2006 MST"), nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOffsetAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		at       time.Time
+		want     time.Duration
+	}{
+		{"Москва, зима 2010", "Europe/Moscow", time.Date(2010, 1, 15, 12, 0, 0, 0, time.UTC), 3 * time.Hour},
+		{"Москва, летнее время 2010", "Europe/Moscow", time.Date(2010, 7, 1, 12, 0, 0, 0, time.UTC), 4 * time.Hour},
+		{"Москва, постоянное летнее 2013", "Europe/Moscow", time.Date(2013, 1, 15, 12, 0, 0, 0, time.UTC), 4 * time.Hour},
+		{"Москва после 2014", "Europe/Moscow", time.Date(2025, 10, 12, 12, 0, 0, 0, time.UTC), 3 * time.Hour},
+		{"Владивосток 2013", "Asia/Vladivostok", time.Date(2013, 1, 15, 12, 0, 0, 0, time.UTC), 11 * time.Hour},
+		{"Владивосток 2025", "Asia/Vladivostok", time.Date(2025, 10, 12, 12, 0, 0, 0, time.UTC), 10 * time.Hour},
+		{"Омск до 2016", "Asia/Omsk", time.Date(2015, 6, 1, 12, 0, 0, 0, time.UTC), 6 * time.Hour},
+		{"Берлин, зима", "Europe/Berlin", time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC), time.Hour},
+		{"Берлин, лето", "Europe/Berlin", time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC), 2 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OffsetAt(tt.timezone, tt.at)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("OffsetAt(%s, %v) = %v, want %v", tt.timezone, tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTimezoneDifference(t *testing.T) {
+	tests := []struct {
+		name     string
+		tz1, tz2 string
+		at       time.Time
+		want     time.Duration
+	}{
+		{"Москва - Хабаровск", "Europe/Moscow", "Asia/Vladivostok", time.Date(2025, 10, 12, 12, 0, 0, 0, time.UTC), 7 * time.Hour},
+		{"Хабаровск - Москва", "Asia/Vladivostok", "Europe/Moscow", time.Date(2025, 10, 12, 12, 0, 0, 0, time.UTC), -7 * time.Hour},
+		{"Новосибирск - Красноярск", "Asia/Novosibirsk", "Asia/Krasnoyarsk", time.Date(2025, 10, 12, 12, 0, 0, 0, time.UTC), 0},
+		{"Новосибирск - Красноярск до 2016", "Asia/Novosibirsk", "Asia/Krasnoyarsk", time.Date(2015, 10, 12, 12, 0, 0, 0, time.UTC), time.Hour},
+		{"Москва - Берлин зимой", "Europe/Moscow", "Europe/Berlin", time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC), -2 * time.Hour},
+		{"Москва - Берлин летом", "Europe/Moscow", "Europe/Berlin", time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC), -time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetTimezoneDifference(tt.tz1, tt.tz2, tt.at)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("GetTimezoneDifference(%s, %s) = %v, want %v", tt.tz1, tt.tz2, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadLocationCaches(t *testing.T) {
+	first, err := LoadLocation("Asia/Irkutsk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := LoadLocation("Asia/Irkutsk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("expected the cached location on the second load")
+	}
+
+	for range 2 {
+		if _, err := LoadLocation("Mars/Olympus"); err == nil {
+			t.Error("expected an error for an unknown timezone")
+		}
+	}
+	if _, err := OffsetAt("Mars/Olympus", time.Now()); err == nil {
+		t.Error("expected OffsetAt to fail for an unknown timezone")
+	}
+}
@@ -0,0 +1,7 @@
+//go:build tzdata
+
+package utils
+
+// Встроенная база часовых поясов (около 450 КБ) для контейнеров без /usr/share/zoneinfo:
+// go build -tags tzdata ./cmd/server
+import _ "time/tzdata"
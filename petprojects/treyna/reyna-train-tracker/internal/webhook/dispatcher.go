@@ -0,0 +1,347 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"reyna-train-tracker/internal/clock"
+	"reyna-train-tracker/internal/config"
+)
+
+// maxResponseBody сколько байт ответа получателя читаем (остальное отбрасываем)
+const maxResponseBody = 64 << 10
+
+// ErrDispatcherClosed диспетчер остановлен и не принимает доставки
+var ErrDispatcherClosed = errors.New("webhook dispatcher is closed")
+
+// Options параметры доставки
+type Options struct {
+	Workers      int           // Параллельные доставки
+	QueueSize    int           // Очередь доставок; при переполнении - сразу в мёртвые письма
+	MaxAttempts  int           // Попыток на одну доставку
+	BaseBackoff  time.Duration // Пауза перед второй попыткой, дальше удваивается
+	MaxBackoff   time.Duration // Потолок паузы
+	Timeout      time.Duration // Таймаут одного HTTP-запроса
+	Clock        clock.Clock   // nil - системные часы
+	HTTP         *http.Client  // nil - клиент с Timeout, без редиректов и внутренних адресов
+	AllowPrivate bool          // Получатели в локальной сети (loopback, RFC 1918); только для клиента по умолчанию
+	Logger       *log.Logger   // nil - log.Default()
+}
+
+// delivery одна доставка события одному подписчику
+type delivery struct {
+	sub   Subscription
+	event Event
+}
+
+// Dispatcher рассылает события подписчикам: подпись HMAC, повторы
+// с экспоненциальной паузой и джиттером, мёртвые письма после всех попыток
+// Паттерн: Worker Pool поверх буферизованного канала
+type Dispatcher struct {
+	Store *Store
+	opts  Options
+
+	queue chan delivery
+	stop  chan struct{} // Закрывается в Close: прерывает паузы между попытками
+	wg    sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+
+	delivered atomic.Int64
+	retries   atomic.Int64
+	dead      atomic.Int64
+}
+
+// NewDispatcher создаёт диспетчер и запускает воркеров
+func NewDispatcher(store *Store, opts Options) *Dispatcher {
+	if opts.Workers <= 0 {
+		opts.Workers = 1
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 1
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.Real()
+	}
+	if opts.HTTP == nil {
+		opts.HTTP = newHTTPClient(opts.Timeout, opts.AllowPrivate)
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.Default()
+	}
+
+	d := &Dispatcher{
+		Store: store,
+		opts:  opts,
+		queue: make(chan delivery, max(opts.QueueSize, 0)),
+		stop:  make(chan struct{}),
+	}
+	for range opts.Workers {
+		d.wg.Add(1)
+		go d.worker()
+	}
+	return d
+}
+
+// NewDispatcherWithConfig создаёт диспетчер по конфигурации.
+// Доставки идут по системным часам, а не по часам трекера:
+// таймауты и проверка подписи у получателей живут в реальном времени
+func NewDispatcherWithConfig(cfg *config.Config) (*Dispatcher, error) {
+	store, err := NewStore(cfg.WebhookStorePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewDispatcher(store, Options{
+		Workers:      cfg.WebhookWorkers,
+		QueueSize:    cfg.WebhookQueueSize,
+		MaxAttempts:  cfg.WebhookMaxAttempts,
+		BaseBackoff:  cfg.WebhookBackoff,
+		MaxBackoff:   cfg.WebhookMaxBackoff,
+		Timeout:      cfg.WebhookTimeout,
+		AllowPrivate: cfg.WebhookAllowPrivate,
+	}), nil
+}
+
+// Subscribe регистрирует URL; без секрета он генерируется
+func (d *Dispatcher) Subscribe(url, secret string, events []EventType) (Subscription, error) {
+	if secret == "" {
+		secret = NewSecret()
+	}
+	if events == nil {
+		events = []EventType{}
+	}
+	sub := Subscription{
+		ID:        newID(),
+		URL:       url,
+		Secret:    secret,
+		Events:    events,
+		CreatedAt: d.opts.Clock.Now(),
+	}
+	if err := sub.Validate(); err != nil {
+		return Subscription{}, err
+	}
+	if err := d.Store.AddSubscription(sub); err != nil {
+		return Subscription{}, err
+	}
+	return sub, nil
+}
+
+// Publish ставит событие в очередь всем подписчикам на его тип.
+// Возвращает число поставленных доставок
+func (d *Dispatcher) Publish(e Event) int {
+	queued := 0
+	for _, sub := range d.Store.Subscriptions() {
+		if !sub.Wants(e.Type) {
+			continue
+		}
+		if err := d.enqueue(delivery{sub: sub, event: e}); err != nil {
+			d.deadLetter(delivery{sub: sub, event: e}, 0, 0, err)
+			continue
+		}
+		queued++
+	}
+	return queued
+}
+
+// Replay повторяет доставку мёртвого письма текущему подписчику (секрет и URL - из подписки)
+func (d *Dispatcher) Replay(id string) (DeadLetter, error) {
+	letter, err := d.Store.TakeDeadLetter(id)
+	if err != nil {
+		return DeadLetter{}, err
+	}
+
+	sub, err := d.Store.Subscription(letter.SubscriptionID)
+	if err == nil {
+		err = d.enqueue(delivery{sub: sub, event: letter.Event})
+	}
+	if err != nil {
+		// Письмо возвращается в хранилище, чтобы его можно было повторить позже
+		if restoreErr := d.Store.AddDeadLetter(letter); restoreErr != nil {
+			return DeadLetter{}, errors.Join(err, restoreErr)
+		}
+		return DeadLetter{}, err
+	}
+	return letter, nil
+}
+
+// enqueue кладёт доставку в очередь без ожидания
+func (d *Dispatcher) enqueue(job delivery) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		return ErrDispatcherClosed
+	}
+	select {
+	case d.queue <- job:
+		return nil
+	default:
+		return errors.New("webhook queue is full")
+	}
+}
+
+// Stats счётчики доставок
+func (d *Dispatcher) Stats() map[string]interface{} {
+	return map[string]interface{}{
+		"subscriptions": len(d.Store.Subscriptions()),
+		"queued":        len(d.queue),
+		"delivered":     d.delivered.Load(),
+		"retries":       d.retries.Load(),
+		"dead_letters":  d.dead.Load(),
+	}
+}
+
+// Close перестаёт принимать события, прерывает паузы между попытками
+// (такие доставки уходят в мёртвые письма) и дожидается воркеров
+func (d *Dispatcher) Close(ctx context.Context) error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil
+	}
+	d.closed = true
+	close(d.queue)
+	close(d.stop)
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *Dispatcher) worker() {
+	defer d.wg.Done()
+	for job := range d.queue {
+		d.deliver(job)
+	}
+}
+
+// deliver отправляет событие с повторами; после последней неудачи - в мёртвые письма
+func (d *Dispatcher) deliver(job delivery) {
+	var (
+		status int
+		err    error
+	)
+	for attempt := 1; ; attempt++ {
+		var retryAfter time.Duration
+		status, retryAfter, err = d.send(job)
+		if err == nil {
+			d.delivered.Add(1)
+			return
+		}
+		if errors.Is(err, ErrBlockedAddress) || !retryable(status) || attempt >= d.opts.MaxAttempts {
+			d.deadLetter(job, attempt, status, err)
+			return
+		}
+
+		wait := max(d.backoff(attempt), min(retryAfter, d.opts.MaxBackoff))
+		select {
+		case <-d.stop:
+			d.deadLetter(job, attempt, status, fmt.Errorf("%w (retry cancelled by shutdown)", err))
+			return
+		case <-d.opts.Clock.After(wait):
+		}
+		d.retries.Add(1)
+	}
+}
+
+// send одна попытка: POST с подписью. Возвращает статус ответа (0 - ответа нет)
+// и паузу из Retry-After, если получатель её указал
+func (d *Dispatcher) send(job delivery) (int, time.Duration, error) {
+	body, err := json.Marshal(job.event)
+	if err != nil {
+		return 0, 0, fmt.Errorf("encode event: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, job.sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, fmt.Errorf("build request: %w", err)
+	}
+	timestamp := d.opts.Clock.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "reyna-train-tracker-webhooks")
+	req.Header.Set(HeaderEvent, string(job.event.Type))
+	req.Header.Set(HeaderDelivery, job.event.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(job.sub.Secret, timestamp, body))
+
+	resp, err := d.opts.HTTP.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, 0, nil
+	}
+	var retryAfter time.Duration
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+	return resp.StatusCode, retryAfter, fmt.Errorf("unexpected status %d", resp.StatusCode)
+}
+
+// retryable имеет ли смысл повторять: нет ответа, таймаут, перегрузка или ошибка сервера.
+// Остальные 4xx означают, что получатель не примет событие и при повторе
+func retryable(status int) bool {
+	return status == 0 || status == http.StatusRequestTimeout ||
+		status == http.StatusTooManyRequests || status >= 500
+}
+
+// backoff пауза перед попыткой attempt+1: BaseBackoff * 2^(attempt-1), не больше MaxBackoff,
+// из которой случайна вторая половина (equal jitter), чтобы подписчики не получали волну повторов разом
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	wait := d.opts.BaseBackoff
+	for i := 1; i < attempt && wait < d.opts.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if d.opts.MaxBackoff > 0 {
+		wait = min(wait, d.opts.MaxBackoff)
+	}
+	if wait <= 0 {
+		return 0
+	}
+	half := wait / 2
+	return half + rand.N(wait-half+1)
+}
+
+// deadLetter сохраняет неудавшуюся доставку
+func (d *Dispatcher) deadLetter(job delivery, attempts, status int, cause error) {
+	d.dead.Add(1)
+	letter := DeadLetter{
+		ID:             newID(),
+		SubscriptionID: job.sub.ID,
+		URL:            job.sub.URL,
+		Event:          job.event,
+		Attempts:       attempts,
+		LastStatus:     status,
+		LastError:      cause.Error(),
+		FailedAt:       d.opts.Clock.Now(),
+	}
+	if err := d.Store.AddDeadLetter(letter); err != nil {
+		d.opts.Logger.Printf("❌ Вебхук %s: не удалось сохранить мёртвое письмо: %v", job.sub.URL, err)
+		return
+	}
+	d.opts.Logger.Printf("⚠️  Вебхук %s: событие %s не доставлено после %d попыток: %v", job.sub.URL, job.event.ID, attempts, cause)
+}
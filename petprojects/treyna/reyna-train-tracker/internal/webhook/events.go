@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"reyna-train-tracker/internal/journal"
+	"reyna-train-tracker/internal/models"
+	"reyna-train-tracker/internal/tracker"
+)
+
+// FromMilestone событие по расписанию; смены дат вебхуками не рассылаются.
+// ID детерминирован, чтобы получатель мог отбросить повтор после перезапуска сервера
+func FromMilestone(m models.Milestone, tripID string) (Event, bool) {
+	e := Event{
+		At:       m.At,
+		Source:   SourceSchedule,
+		TripID:   tripID,
+		Station:  m.Station,
+		Timezone: m.Timezone,
+	}
+	switch m.Kind {
+	case models.MilestoneArrival:
+		e.Type = EventArrival
+	case models.MilestoneDeparture:
+		e.Type = EventDeparture
+	case models.MilestoneTimezone:
+		e.Type = EventTimezone
+		e.Shift = m.Shift.String()
+	default:
+		return Event{}, false
+	}
+	e.ID = fmt.Sprintf("%s-%s-%s-%d", SourceSchedule, tripID, e.Type, m.At.Unix())
+	return e, true
+}
+
+// FromJournal событие из журнала поездки; запросы позиции не рассылаются
+func FromJournal(je journal.Event, tripID string) (Event, bool) {
+	e := Event{
+		ID:        fmt.Sprintf("%s-%s-%d", SourceJournal, tripID, je.Seq),
+		At:        je.At,
+		Source:    SourceJournal,
+		TripID:    tripID,
+		StationID: je.StationID,
+		Station:   je.Station,
+		Note:      je.Note,
+	}
+	switch je.Type {
+	case journal.EventArrival:
+		e.Type = EventArrival
+	case journal.EventDeparture:
+		e.Type = EventDeparture
+	case journal.EventDelay:
+		e.Type = EventDelay
+		e.Delay = je.Delay.String()
+	default:
+		return Event{}, false
+	}
+	return e, true
+}
+
+// PublishMilestones публикует события расписания по мере того, как до них доходят
+// часы трекера (с CLOCK_SPEED - ускоренно), до прибытия на конечную или отмены ctx
+func (d *Dispatcher) PublishMilestones(ctx context.Context, t *tracker.TrainTracker) {
+	if len(t.Stations) == 0 {
+		return
+	}
+	clk := t.Clock
+	arrival := t.Stations[len(t.Stations)-1].ArrivalTime
+
+	for _, m := range t.Milestones(clk.Now(), arrival) {
+		e, ok := FromMilestone(m, t.TripID)
+		if !ok {
+			continue
+		}
+		if wait := m.At.Sub(clk.Now()); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-clk.After(wait):
+			}
+		}
+		d.Publish(e)
+	}
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"time"
+)
+
+// ErrBlockedAddress получатель во внутренней сети: loopback, RFC 1918, link-local и т.п.
+var ErrBlockedAddress = errors.New("webhook receiver address is not public")
+
+// blockedPrefixes внутренние сети, которых нет среди проверок netip.Addr
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "Эта" сеть
+	netip.MustParsePrefix("100.64.0.0/10"), // CGNAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // Служебные адреса IETF
+	netip.MustParsePrefix("198.18.0.0/15"), // Стенды для тестов производительности
+}
+
+// publicAddr можно ли слать вебхук на адрес ip
+func publicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsUnspecified() || ip.IsLoopback() || ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// newHTTPClient клиент доставок. Без allowPrivate соединяется только с публичными
+// адресами: хост резолвится при каждом соединении, так что смена DNS-записи
+// после подписки проверку не обойдёт. Редиректы не выполняются - ответ 3xx
+// считается неудачной доставкой, иначе получатель перенаправил бы запрос внутрь сети
+func newHTTPClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // Прокси соединялся бы с получателем сам, в обход проверки
+	if !allowPrivate {
+		transport.DialContext = publicDialContext(&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second})
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// publicDialContext DialContext, который резолвит хост сам и соединяется
+// только с публичными адресами из ответа
+func publicDialContext(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		ips, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return nil, err
+		}
+
+		dialErr := fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+		for _, ip := range ips {
+			if !publicAddr(ip) {
+				continue
+			}
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.Unmap().String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			dialErr = err
+		}
+		return nil, dialErr
+	}
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Заголовки доставки
+const (
+	HeaderEvent     = "X-Reyna-Event"     // Тип события
+	HeaderDelivery  = "X-Reyna-Delivery"  // ID события (одинаковый во всех попытках)
+	HeaderTimestamp = "X-Reyna-Timestamp" // Unix-время подписи
+	HeaderSignature = "X-Reyna-Signature" // "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body))
+)
+
+// signaturePrefix схема подписи в заголовке
+const signaturePrefix = "sha256="
+
+// ErrInvalidSignature подпись не совпала или устарела
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Sign подпись тела запроса. Метка времени входит в подпись,
+// чтобы перехваченный запрос нельзя было повторить позже
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись на стороне получателя: значения заголовков
+// X-Reyna-Timestamp и X-Reyna-Signature, тело и допустимый возраст подписи
+func Verify(secret, timestamp, signature string, body []byte, now time.Time, tolerance time.Duration) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || !strings.HasPrefix(signature, signaturePrefix) {
+		return ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(ts, 0)); tolerance > 0 && (age > tolerance || age < -tolerance) {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(Sign(secret, ts, body)), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// newID случайный идентификатор: 16 байт в hex
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// NewSecret случайный секрет подписи для новой подписки
+func NewSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,196 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Ошибки хранилища
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrDeadLetterNotFound   = errors.New("dead letter not found")
+)
+
+// storeFile содержимое файла хранилища
+type storeFile struct {
+	Subscriptions []Subscription `json:"subscriptions"`
+	DeadLetters   []DeadLetter   `json:"dead_letters"`
+}
+
+// Store подписки и мёртвые письма в памяти.
+// С путём к файлу каждое изменение сохраняется в JSON (запись во временный файл и rename)
+type Store struct {
+	path string
+
+	mu            sync.RWMutex
+	subscriptions map[string]Subscription
+	deadLetters   map[string]DeadLetter
+}
+
+// NewStore создаёт хранилище; пустой path - только в памяти.
+// Отсутствие файла - не ошибка: он появится при первом изменении
+func NewStore(path string) (*Store, error) {
+	s := &Store{
+		path:          path,
+		subscriptions: make(map[string]Subscription),
+		deadLetters:   make(map[string]DeadLetter),
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook store: %w", err)
+	}
+
+	var file storeFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook store %s: %w", path, err)
+	}
+	for _, sub := range file.Subscriptions {
+		s.subscriptions[sub.ID] = sub
+	}
+	for _, letter := range file.DeadLetters {
+		s.deadLetters[letter.ID] = letter
+	}
+	return s, nil
+}
+
+// AddSubscription сохраняет подписку
+func (s *Store) AddSubscription(sub Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscriptions[sub.ID] = sub
+	return s.saveLocked()
+}
+
+// Subscription подписка по ID
+func (s *Store) Subscription(id string) (Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sub, ok := s.subscriptions[id]
+	if !ok {
+		return Subscription{}, ErrSubscriptionNotFound
+	}
+	return sub, nil
+}
+
+// Subscriptions все подписки по времени создания
+func (s *Store) Subscriptions() []Subscription {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subs := make([]Subscription, 0, len(s.subscriptions))
+	for _, sub := range s.subscriptions {
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].CreatedAt.Before(subs[j].CreatedAt)
+	})
+	return subs
+}
+
+// DeleteSubscription удаляет подписку; её мёртвые письма остаются для разбора
+func (s *Store) DeleteSubscription(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[id]; !ok {
+		return ErrSubscriptionNotFound
+	}
+	delete(s.subscriptions, id)
+	return s.saveLocked()
+}
+
+// AddDeadLetter сохраняет окончательно неудавшуюся доставку
+func (s *Store) AddDeadLetter(letter DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deadLetters[letter.ID] = letter
+	return s.saveLocked()
+}
+
+// DeadLetters все мёртвые письма по времени отказа
+func (s *Store) DeadLetters() []DeadLetter {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	letters := make([]DeadLetter, 0, len(s.deadLetters))
+	for _, letter := range s.deadLetters {
+		letters = append(letters, letter)
+	}
+	sort.Slice(letters, func(i, j int) bool {
+		return letters[i].FailedAt.Before(letters[j].FailedAt)
+	})
+	return letters
+}
+
+// TakeDeadLetter удаляет мёртвое письмо и возвращает его (для повторной отправки)
+func (s *Store) TakeDeadLetter(id string) (DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	letter, ok := s.deadLetters[id]
+	if !ok {
+		return DeadLetter{}, ErrDeadLetterNotFound
+	}
+	delete(s.deadLetters, id)
+	if err := s.saveLocked(); err != nil {
+		s.deadLetters[id] = letter
+		return DeadLetter{}, err
+	}
+	return letter, nil
+}
+
+// saveLocked записывает хранилище в файл, если он задан
+func (s *Store) saveLocked() error {
+	if s.path == "" {
+		return nil
+	}
+
+	file := storeFile{
+		Subscriptions: make([]Subscription, 0, len(s.subscriptions)),
+		DeadLetters:   make([]DeadLetter, 0, len(s.deadLetters)),
+	}
+	for _, sub := range s.subscriptions {
+		file.Subscriptions = append(file.Subscriptions, sub)
+	}
+	for _, letter := range s.deadLetters {
+		file.DeadLetters = append(file.DeadLetters, letter)
+	}
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook store: %w", err)
+	}
+
+	// CreateTemp создаёт файл с правами 0600: в нём секреты подписей
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create webhook store file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write webhook store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write webhook store: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace webhook store: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// EventType тип события, на которое можно подписаться
+type EventType string
+
+const (
+	EventArrival   EventType = "arrival"         // Прибытие на станцию
+	EventDeparture EventType = "departure"       // Отправление со станции
+	EventDelay     EventType = "delay"           // Сообщение об опоздании (из журнала)
+	EventTimezone  EventType = "timezone_change" // Пересечение границы часовых поясов
+)
+
+// EventTypes все типы событий
+func EventTypes() []EventType {
+	return []EventType{EventArrival, EventDeparture, EventDelay, EventTimezone}
+}
+
+// Источник события
+const (
+	SourceSchedule = "schedule" // По расписанию (часы трекера дошли до события)
+	SourceJournal  = "journal"  // Наблюдение, записанное через POST /api/events
+)
+
+// Event событие трекера, которое доставляется подписчикам
+type Event struct {
+	ID        string    `json:"id"`
+	Type      EventType `json:"type"`
+	At        time.Time `json:"at"`
+	Source    string    `json:"source"`
+	TripID    string    `json:"trip_id,omitempty"`
+	StationID int       `json:"station_id,omitempty"`
+	Station   string    `json:"station,omitempty"`
+	Timezone  string    `json:"timezone,omitempty"`
+	Shift     string    `json:"clock_shift,omitempty"` // Перевод часов: "+1h0m0s"
+	Delay     string    `json:"delay,omitempty"`       // Опоздание: "25m0s"
+	Note      string    `json:"note,omitempty"`
+}
+
+// Subscription подписка на события: URL получателя, секрет для подписи и фильтр типов
+type Subscription struct {
+	ID        string      `json:"id"`
+	URL       string      `json:"url"`
+	Secret    string      `json:"secret,omitempty"`
+	Events    []EventType `json:"events"` // Пустой список - все события
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// Wants подписан ли получатель на событие типа t
+func (s Subscription) Wants(t EventType) bool {
+	return len(s.Events) == 0 || slices.Contains(s.Events, t)
+}
+
+// Public копия подписки без секрета - для списков
+func (s Subscription) Public() Subscription {
+	s.Secret = ""
+	return s
+}
+
+// Validate проверяет URL и типы событий подписки
+func (s Subscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %q: expected http(s)://host/path", s.URL)
+	}
+	for _, t := range s.Events {
+		if !slices.Contains(EventTypes(), t) {
+			return fmt.Errorf("unknown event type: %q", t)
+		}
+	}
+	return nil
+}
+
+// DeadLetter доставка, от которой отказались после всех попыток
+type DeadLetter struct {
+	ID             string    `json:"id"`
+	SubscriptionID string    `json:"subscription_id"`
+	URL            string    `json:"url"`
+	Event          Event     `json:"event"`
+	Attempts       int       `json:"attempts"`
+	LastStatus     int       `json:"last_status,omitempty"` // HTTP-статус последней попытки (0 - нет ответа)
+	LastError      string    `json:"last_error"`
+	FailedAt       time.Time `json:"failed_at"`
+}
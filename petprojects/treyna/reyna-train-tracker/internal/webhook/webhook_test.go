@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"id":"e1"}`)
+	now := time.Unix(1760000000, 0)
+	signature := Sign("secret", now.Unix(), body)
+	timestamp := "1760000000"
+
+	if err := Verify("secret", timestamp, signature, body, now, time.Minute); err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+	if err := Verify("other", timestamp, signature, body, now, time.Minute); err == nil {
+		t.Error("signature with a wrong secret accepted")
+	}
+	if err := Verify("secret", timestamp, signature, []byte(`{"id":"e2"}`), now, time.Minute); err == nil {
+		t.Error("signature of a different body accepted")
+	}
+	if err := Verify("secret", timestamp, signature, body, now.Add(time.Hour), time.Minute); err == nil {
+		t.Error("stale signature accepted")
+	}
+}
+
+// TestRetryDeadLetterReplay неудачные попытки уходят в мёртвые письма,
+// а повтор после починки получателя доставляет событие
+func TestRetryDeadLetterReplay(t *testing.T) {
+	var healthy atomic.Bool
+	var calls atomic.Int32
+	received := make(chan Event, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if err := Verify("secret", r.Header.Get(HeaderTimestamp), r.Header.Get(HeaderSignature), body, time.Now(), time.Minute); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received <- Event{ID: r.Header.Get(HeaderDelivery), Type: EventType(r.Header.Get(HeaderEvent))}
+	}))
+	defer receiver.Close()
+
+	store, err := NewStore(filepath.Join(t.TempDir(), "webhooks.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDispatcher(store, Options{Workers: 1, QueueSize: 4, MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Timeout: time.Second, AllowPrivate: true})
+	defer d.Close(context.Background())
+
+	if _, err := d.Subscribe(receiver.URL, "secret", []EventType{EventDelay}); err != nil {
+		t.Fatal(err)
+	}
+	if n := d.Publish(Event{ID: "arrival-1", Type: EventArrival}); n != 0 {
+		t.Fatalf("arrival must be filtered out, queued %d", n)
+	}
+	if n := d.Publish(Event{ID: "delay-1", Type: EventDelay}); n != 1 {
+		t.Fatalf("expected 1 delivery, queued %d", n)
+	}
+
+	var letters []DeadLetter
+	for deadline := time.Now().Add(5 * time.Second); len(letters) == 0 && time.Now().Before(deadline); {
+		time.Sleep(5 * time.Millisecond)
+		letters = store.DeadLetters()
+	}
+	if len(letters) != 1 || letters[0].Attempts != 3 || letters[0].LastStatus != http.StatusServiceUnavailable {
+		t.Fatalf("unexpected dead letters: %+v", letters)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 attempts, receiver saw %d", calls.Load())
+	}
+
+	// Хранилище переживает перезапуск
+	reopened, err := NewStore(store.path)
+	if err != nil || len(reopened.DeadLetters()) != 1 || len(reopened.Subscriptions()) != 1 {
+		t.Fatalf("store was not persisted: %v", err)
+	}
+
+	healthy.Store(true)
+	if _, err := d.Replay(letters[0].ID); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	select {
+	case e := <-received:
+		if e.ID != "delay-1" || e.Type != EventDelay {
+			t.Errorf("unexpected delivery: %+v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("replayed event was not delivered")
+	}
+	if len(store.DeadLetters()) != 0 {
+		t.Errorf("replayed letter is still dead: %+v", store.DeadLetters())
+	}
+	if _, err := d.Replay(letters[0].ID); err != ErrDeadLetterNotFound {
+		t.Errorf("second replay: expected ErrDeadLetterNotFound, got %v", err)
+	}
+}
+
+func TestPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.10", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fc00::1", false},
+		{"0.0.0.0", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"::ffff:127.0.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := publicAddr(netip.MustParseAddr(tt.addr)); got != tt.public {
+			t.Errorf("%s: public=%v, want %v", tt.addr, got, tt.public)
+		}
+	}
+}
+
+// TestReceiverGuard по умолчанию доставка во внутреннюю сеть и по редиректу не идёт
+func TestReceiverGuard(t *testing.T) {
+	var internalCalls atomic.Int32
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		internalCalls.Add(1)
+	}))
+	defer internal.Close()
+	redirect := httptest.NewServer(http.RedirectHandler(internal.URL, http.StatusFound))
+	defer redirect.Close()
+
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		wantStatus   int
+		wantErr      string
+	}{
+		{name: "loopback", url: internal.URL, wantErr: ErrBlockedAddress.Error()},
+		{name: "localhost", url: strings.Replace(internal.URL, "127.0.0.1", "localhost", 1), wantErr: ErrBlockedAddress.Error()},
+		{name: "redirect", url: redirect.URL, allowPrivate: true, wantStatus: http.StatusFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, _ := NewStore("")
+			d := NewDispatcher(store, Options{QueueSize: 1, MaxAttempts: 3, BaseBackoff: time.Millisecond, Timeout: time.Second, AllowPrivate: tt.allowPrivate})
+			defer d.Close(context.Background())
+
+			if _, err := d.Subscribe(tt.url, "secret", nil); err != nil {
+				t.Fatal(err)
+			}
+			d.Publish(Event{ID: "arrival-1", Type: EventArrival})
+
+			var letters []DeadLetter
+			for deadline := time.Now().Add(5 * time.Second); len(letters) == 0 && time.Now().Before(deadline); {
+				time.Sleep(5 * time.Millisecond)
+				letters = store.DeadLetters()
+			}
+			if len(letters) != 1 || letters[0].Attempts != 1 || letters[0].LastStatus != tt.wantStatus ||
+				!strings.Contains(letters[0].LastError, tt.wantErr) {
+				t.Fatalf("expected one dead letter without retries, got %+v", letters)
+			}
+		})
+	}
+	if internalCalls.Load() != 0 {
+		t.Errorf("internal receiver was reached %d times", internalCalls.Load())
+	}
+}
@@ -0,0 +1,138 @@
+// Package trackerclient небольшой Go-клиент gRPC API трекера
+package trackerclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"reyna-train-tracker/pkg/trackerpb"
+)
+
+// Client соединение с TrackerService. Полный набор RPC доступен через встроенный
+// trackerpb.TrackerServiceClient, методы ниже - короткие обёртки для частых вызовов
+type Client struct {
+	trackerpb.TrackerServiceClient
+
+	conn *grpc.ClientConn
+	lang string
+}
+
+// Option настройка клиента
+type Option func(*options)
+
+type options struct {
+	lang     string
+	apiKey   string
+	dialOpts []grpc.DialOption
+}
+
+// WithLang язык ответов ("ru", "en"); без него - язык сервера
+func WithLang(lang string) Option {
+	return func(o *options) { o.lang = lang }
+}
+
+// WithAPIKey ключ API: отправляется в каждом вызове как "authorization: Bearer <ключ>"
+func WithAPIKey(key string) Option {
+	return func(o *options) { o.apiKey = key }
+}
+
+// WithDialOptions опции соединения: TLS, перехватчики и т.п.
+// Без них соединение открывается без шифрования
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) { o.dialOpts = append(o.dialOpts, opts...) }
+}
+
+// Dial создаёт клиента для target ("localhost:9090"). Соединение ленивое:
+// ошибка сети проявится при первом вызове
+func Dial(target string, opts ...Option) (*Client, error) {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if len(o.dialOpts) == 0 {
+		o.dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	if o.apiKey != "" {
+		o.dialOpts = append(o.dialOpts, grpc.WithPerRPCCredentials(apiKeyCredentials(o.apiKey)))
+	}
+
+	conn, err := grpc.NewClient(target, o.dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", target, err)
+	}
+	return &Client{TrackerServiceClient: trackerpb.NewTrackerServiceClient(conn), conn: conn, lang: o.lang}, nil
+}
+
+// Close закрывает соединение
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Position позиция поезда в момент at (нулевой - сейчас по часам трекера)
+func (c *Client) Position(ctx context.Context, at time.Time) (*trackerpb.Position, error) {
+	return c.GetPosition(ctx, c.timeRequest(at))
+}
+
+// Question ответ на вопрос по номеру в момент at (нулевой - сейчас)
+func (c *Client) Question(ctx context.Context, question int, at time.Time) (*trackerpb.AskResponse, error) {
+	req := &trackerpb.AskRequest{Question: int32(question), Lang: c.lang}
+	if !at.IsZero() {
+		req.At = timestamppb.New(at)
+	}
+	return c.Ask(ctx, req)
+}
+
+// Watch вызывает fn для каждой позиции из WatchPosition, пока не отменён ctx,
+// сервер не закрыл поток или fn не вернула ошибку. Отмена ctx - не ошибка
+func (c *Client) Watch(ctx context.Context, interval time.Duration, onlyChanges bool, fn func(*trackerpb.Position) error) error {
+	req := &trackerpb.WatchPositionRequest{Lang: c.lang, OnlyChanges: onlyChanges}
+	if interval > 0 {
+		req.Interval = durationpb.New(interval)
+	}
+
+	stream, err := c.WatchPosition(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		pos, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if err := fn(pos); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) timeRequest(at time.Time) *trackerpb.TimeRequest {
+	req := &trackerpb.TimeRequest{Lang: c.lang}
+	if !at.IsZero() {
+		req.At = timestamppb.New(at)
+	}
+	return req
+}
+
+// apiKeyCredentials ключ API в метаданных вызова. Передаётся и без TLS:
+// трекер часто слушает только локальную сеть
+type apiKeyCredentials string
+
+func (k apiKeyCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(k)}, nil
+}
+
+func (k apiKeyCredentials) RequireTransportSecurity() bool { return false }
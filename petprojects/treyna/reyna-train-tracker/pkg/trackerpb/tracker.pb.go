@@ -0,0 +1,1711 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: reyna/tracker/v1/tracker.proto
+
+// gRPC API трекера: те же данные, что в REST, но с типизированными ответами
+
+package trackerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRequest) Reset() {
+	*x = TimeRequest{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRequest) ProtoMessage() {}
+
+func (x *TimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRequest.ProtoReflect.Descriptor instead.
+func (*TimeRequest) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimeRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *TimeRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+type Station struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // Название из расписания
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Название на языке запроса
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	ArrivalTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	DepartureTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	StandDuration *durationpb.Duration   `protobuf:"bytes,7,opt,name=stand_duration,json=standDuration,proto3" json:"stand_duration,omitempty"`
+	DistanceKm    int32                  `protobuf:"varint,8,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	Major         bool                   `protobuf:"varint,9,opt,name=major,proto3" json:"major,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Station) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Station) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Station) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Station) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Station) GetArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return nil
+}
+
+func (x *Station) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+func (x *Station) GetStandDuration() *durationpb.Duration {
+	if x != nil {
+		return x.StandDuration
+	}
+	return nil
+}
+
+func (x *Station) GetDistanceKm() int32 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Station) GetMajor() bool {
+	if x != nil {
+		return x.Major
+	}
+	return false
+}
+
+type Position struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	At              *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	AtStation       bool                   `protobuf:"varint,2,opt,name=at_station,json=atStation,proto3" json:"at_station,omitempty"`
+	CurrentStation  *Station               `protobuf:"bytes,3,opt,name=current_station,json=currentStation,proto3" json:"current_station,omitempty"` // Только на станции
+	PreviousStation *Station               `protobuf:"bytes,4,opt,name=previous_station,json=previousStation,proto3" json:"previous_station,omitempty"`
+	NextStation     *Station               `protobuf:"bytes,5,opt,name=next_station,json=nextStation,proto3" json:"next_station,omitempty"`
+	DistanceKm      float64                `protobuf:"fixed64,6,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	LocalTime       string                 `protobuf:"bytes,7,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"` // RFC3339 со смещением пояса пассажира
+	Timezone        string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Position) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *Position) GetAtStation() bool {
+	if x != nil {
+		return x.AtStation
+	}
+	return false
+}
+
+func (x *Position) GetCurrentStation() *Station {
+	if x != nil {
+		return x.CurrentStation
+	}
+	return nil
+}
+
+func (x *Position) GetPreviousStation() *Station {
+	if x != nil {
+		return x.PreviousStation
+	}
+	return nil
+}
+
+func (x *Position) GetNextStation() *Station {
+	if x != nil {
+		return x.NextStation
+	}
+	return nil
+}
+
+func (x *Position) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Position) GetLocalTime() string {
+	if x != nil {
+		return x.LocalTime
+	}
+	return ""
+}
+
+func (x *Position) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type TrainStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Moving         bool                   `protobuf:"varint,1,opt,name=moving,proto3" json:"moving,omitempty"`
+	RemainingStand *durationpb.Duration   `protobuf:"bytes,2,opt,name=remaining_stand,json=remainingStand,proto3" json:"remaining_stand,omitempty"` // Если стоит
+	TimeToNext     *durationpb.Duration   `protobuf:"bytes,3,opt,name=time_to_next,json=timeToNext,proto3" json:"time_to_next,omitempty"`           // Если едет
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TrainStatus) Reset() {
+	*x = TrainStatus{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainStatus) ProtoMessage() {}
+
+func (x *TrainStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainStatus.ProtoReflect.Descriptor instead.
+func (*TrainStatus) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TrainStatus) GetMoving() bool {
+	if x != nil {
+		return x.Moving
+	}
+	return false
+}
+
+func (x *TrainStatus) GetRemainingStand() *durationpb.Duration {
+	if x != nil {
+		return x.RemainingStand
+	}
+	return nil
+}
+
+func (x *TrainStatus) GetTimeToNext() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToNext
+	}
+	return nil
+}
+
+type DateChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	In            *durationpb.Duration   `protobuf:"bytes,2,opt,name=in,proto3" json:"in,omitempty"`
+	NewDate       string                 `protobuf:"bytes,3,opt,name=new_date,json=newDate,proto3" json:"new_date,omitempty"` // YYYY-MM-DD
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Station       string                 `protobuf:"bytes,5,opt,name=station,proto3" json:"station,omitempty"`
+	ClockShift    bool                   `protobuf:"varint,6,opt,name=clock_shift,json=clockShift,proto3" json:"clock_shift,omitempty"` // Дата сменилась при переводе часов, а не в полночь
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateChange) Reset() {
+	*x = DateChange{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateChange) ProtoMessage() {}
+
+func (x *DateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateChange.ProtoReflect.Descriptor instead.
+func (*DateChange) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DateChange) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *DateChange) GetIn() *durationpb.Duration {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *DateChange) GetNewDate() string {
+	if x != nil {
+		return x.NewDate
+	}
+	return ""
+}
+
+func (x *DateChange) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DateChange) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *DateChange) GetClockShift() bool {
+	if x != nil {
+		return x.ClockShift
+	}
+	return false
+}
+
+type JourneyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DayNumber     int32                  `protobuf:"varint,1,opt,name=day_number,json=dayNumber,proto3" json:"day_number,omitempty"`       // По 24 часа от отправления
+	CalendarDay   int32                  `protobuf:"varint,2,opt,name=calendar_day,json=calendarDay,proto3" json:"calendar_day,omitempty"` // По местному календарю пассажира (0 - вне поездки)
+	LocalDate     string                 `protobuf:"bytes,3,opt,name=local_date,json=localDate,proto3" json:"local_date,omitempty"`        // YYYY-MM-DD
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	TimeInTrip    *durationpb.Duration   `protobuf:"bytes,6,opt,name=time_in_trip,json=timeInTrip,proto3" json:"time_in_trip,omitempty"`
+	DateChanges   []*DateChange          `protobuf:"bytes,7,rep,name=date_changes,json=dateChanges,proto3" json:"date_changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JourneyInfo) Reset() {
+	*x = JourneyInfo{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JourneyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JourneyInfo) ProtoMessage() {}
+
+func (x *JourneyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JourneyInfo.ProtoReflect.Descriptor instead.
+func (*JourneyInfo) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *JourneyInfo) GetDayNumber() int32 {
+	if x != nil {
+		return x.DayNumber
+	}
+	return 0
+}
+
+func (x *JourneyInfo) GetCalendarDay() int32 {
+	if x != nil {
+		return x.CalendarDay
+	}
+	return 0
+}
+
+func (x *JourneyInfo) GetLocalDate() string {
+	if x != nil {
+		return x.LocalDate
+	}
+	return ""
+}
+
+func (x *JourneyInfo) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *JourneyInfo) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *JourneyInfo) GetTimeInTrip() *durationpb.Duration {
+	if x != nil {
+		return x.TimeInTrip
+	}
+	return nil
+}
+
+func (x *JourneyInfo) GetDateChanges() []*DateChange {
+	if x != nil {
+		return x.DateChanges
+	}
+	return nil
+}
+
+// Вопрос 1
+type LocalTimeAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocalTime     string                 `protobuf:"bytes,1,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"` // RFC3339 со смещением
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalTimeAnswer) Reset() {
+	*x = LocalTimeAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalTimeAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalTimeAnswer) ProtoMessage() {}
+
+func (x *LocalTimeAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalTimeAnswer.ProtoReflect.Descriptor instead.
+func (*LocalTimeAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LocalTimeAnswer) GetLocalTime() string {
+	if x != nil {
+		return x.LocalTime
+	}
+	return ""
+}
+
+func (x *LocalTimeAnswer) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Вопрос 2
+type CurrentStationAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AtStation     bool                   `protobuf:"varint,1,opt,name=at_station,json=atStation,proto3" json:"at_station,omitempty"`
+	Station       string                 `protobuf:"bytes,2,opt,name=station,proto3" json:"station,omitempty"`   // На станции
+	Previous      string                 `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"` // Между станциями
+	Next          string                 `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`
+	DistanceKm    int32                  `protobuf:"varint,5,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrentStationAnswer) Reset() {
+	*x = CurrentStationAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrentStationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentStationAnswer) ProtoMessage() {}
+
+func (x *CurrentStationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentStationAnswer.ProtoReflect.Descriptor instead.
+func (*CurrentStationAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CurrentStationAnswer) GetAtStation() bool {
+	if x != nil {
+		return x.AtStation
+	}
+	return false
+}
+
+func (x *CurrentStationAnswer) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *CurrentStationAnswer) GetPrevious() string {
+	if x != nil {
+		return x.Previous
+	}
+	return ""
+}
+
+func (x *CurrentStationAnswer) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *CurrentStationAnswer) GetDistanceKm() int32 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+// Вопрос 3
+type TrainStateAnswer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Moving         bool                   `protobuf:"varint,1,opt,name=moving,proto3" json:"moving,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // Подпись на языке запроса
+	Station        string                 `protobuf:"bytes,3,opt,name=station,proto3" json:"station,omitempty"`
+	StandDuration  *durationpb.Duration   `protobuf:"bytes,4,opt,name=stand_duration,json=standDuration,proto3" json:"stand_duration,omitempty"`
+	RemainingStand *durationpb.Duration   `protobuf:"bytes,5,opt,name=remaining_stand,json=remainingStand,proto3" json:"remaining_stand,omitempty"`
+	From           string                 `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	TimeToNext     *durationpb.Duration   `protobuf:"bytes,8,opt,name=time_to_next,json=timeToNext,proto3" json:"time_to_next,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TrainStateAnswer) Reset() {
+	*x = TrainStateAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainStateAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainStateAnswer) ProtoMessage() {}
+
+func (x *TrainStateAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainStateAnswer.ProtoReflect.Descriptor instead.
+func (*TrainStateAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TrainStateAnswer) GetMoving() bool {
+	if x != nil {
+		return x.Moving
+	}
+	return false
+}
+
+func (x *TrainStateAnswer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TrainStateAnswer) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *TrainStateAnswer) GetStandDuration() *durationpb.Duration {
+	if x != nil {
+		return x.StandDuration
+	}
+	return nil
+}
+
+func (x *TrainStateAnswer) GetRemainingStand() *durationpb.Duration {
+	if x != nil {
+		return x.RemainingStand
+	}
+	return nil
+}
+
+func (x *TrainStateAnswer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TrainStateAnswer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TrainStateAnswer) GetTimeToNext() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToNext
+	}
+	return nil
+}
+
+// Вопрос 4
+type JourneyDayAnswer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Journey        *JourneyInfo           `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	NextDateChange string                 `protobuf:"bytes,2,opt,name=next_date_change,json=nextDateChange,proto3" json:"next_date_change,omitempty"` // Описание ближайшей смены даты
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JourneyDayAnswer) Reset() {
+	*x = JourneyDayAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JourneyDayAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JourneyDayAnswer) ProtoMessage() {}
+
+func (x *JourneyDayAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JourneyDayAnswer.ProtoReflect.Descriptor instead.
+func (*JourneyDayAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JourneyDayAnswer) GetJourney() *JourneyInfo {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+func (x *JourneyDayAnswer) GetNextDateChange() string {
+	if x != nil {
+		return x.NextDateChange
+	}
+	return ""
+}
+
+// Вопрос 5
+type DistanceAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DistanceKm    int32                  `protobuf:"varint,1,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistanceAnswer) Reset() {
+	*x = DistanceAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistanceAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistanceAnswer) ProtoMessage() {}
+
+func (x *DistanceAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistanceAnswer.ProtoReflect.Descriptor instead.
+func (*DistanceAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DistanceAnswer) GetDistanceKm() int32 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *DistanceAnswer) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// Вопрос 6
+type NextArrivalAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Station       string                 `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Scheduled     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Expected      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	Earliest      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=earliest,proto3" json:"earliest,omitempty"`
+	Latest        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=latest,proto3" json:"latest,omitempty"`
+	Delay         *durationpb.Duration   `protobuf:"bytes,6,opt,name=delay,proto3" json:"delay,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,7,opt,name=confidence,proto3" json:"confidence,omitempty"` // 0.8 - 80% прибытий попадают в интервал
+	Observations  int32                  `protobuf:"varint,8,opt,name=observations,proto3" json:"observations,omitempty"`
+	TimeRemaining *durationpb.Duration   `protobuf:"bytes,9,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextArrivalAnswer) Reset() {
+	*x = NextArrivalAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextArrivalAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextArrivalAnswer) ProtoMessage() {}
+
+func (x *NextArrivalAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextArrivalAnswer.ProtoReflect.Descriptor instead.
+func (*NextArrivalAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NextArrivalAnswer) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *NextArrivalAnswer) GetScheduled() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Scheduled
+	}
+	return nil
+}
+
+func (x *NextArrivalAnswer) GetExpected() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *NextArrivalAnswer) GetEarliest() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Earliest
+	}
+	return nil
+}
+
+func (x *NextArrivalAnswer) GetLatest() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *NextArrivalAnswer) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *NextArrivalAnswer) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *NextArrivalAnswer) GetObservations() int32 {
+	if x != nil {
+		return x.Observations
+	}
+	return 0
+}
+
+func (x *NextArrivalAnswer) GetTimeRemaining() *durationpb.Duration {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return nil
+}
+
+type ClockChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Shift         *durationpb.Duration   `protobuf:"bytes,2,opt,name=shift,proto3" json:"shift,omitempty"`
+	In            *durationpb.Duration   `protobuf:"bytes,3,opt,name=in,proto3" json:"in,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,4,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClockChange) Reset() {
+	*x = ClockChange{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClockChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockChange) ProtoMessage() {}
+
+func (x *ClockChange) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockChange.ProtoReflect.Descriptor instead.
+func (*ClockChange) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClockChange) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ClockChange) GetShift() *durationpb.Duration {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *ClockChange) GetIn() *durationpb.Duration {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *ClockChange) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+// Вопрос 7
+type TimeDifferenceAnswer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MoscowTime      string                 `protobuf:"bytes,1,opt,name=moscow_time,json=moscowTime,proto3" json:"moscow_time,omitempty"` // RFC3339
+	LocalTime       string                 `protobuf:"bytes,2,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"`    // RFC3339
+	Timezone        string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Difference      *durationpb.Duration   `protobuf:"bytes,4,opt,name=difference,proto3" json:"difference,omitempty"`                                    // Местное минус московское
+	NextClockChange *ClockChange           `protobuf:"bytes,5,opt,name=next_clock_change,json=nextClockChange,proto3" json:"next_clock_change,omitempty"` // Ближайший перевод часов впереди
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TimeDifferenceAnswer) Reset() {
+	*x = TimeDifferenceAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeDifferenceAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeDifferenceAnswer) ProtoMessage() {}
+
+func (x *TimeDifferenceAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeDifferenceAnswer.ProtoReflect.Descriptor instead.
+func (*TimeDifferenceAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TimeDifferenceAnswer) GetMoscowTime() string {
+	if x != nil {
+		return x.MoscowTime
+	}
+	return ""
+}
+
+func (x *TimeDifferenceAnswer) GetLocalTime() string {
+	if x != nil {
+		return x.LocalTime
+	}
+	return ""
+}
+
+func (x *TimeDifferenceAnswer) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TimeDifferenceAnswer) GetDifference() *durationpb.Duration {
+	if x != nil {
+		return x.Difference
+	}
+	return nil
+}
+
+func (x *TimeDifferenceAnswer) GetNextClockChange() *ClockChange {
+	if x != nil {
+		return x.NextClockChange
+	}
+	return nil
+}
+
+// Вопросы 8 и 9
+type MessageDeliveryAnswer struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SendTime         string                 `protobuf:"bytes,1,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`          // RFC3339 у отправителя
+	ReceiveTime      string                 `protobuf:"bytes,2,opt,name=receive_time,json=receiveTime,proto3" json:"receive_time,omitempty"` // RFC3339 у получателя
+	SenderTimezone   string                 `protobuf:"bytes,3,opt,name=sender_timezone,json=senderTimezone,proto3" json:"sender_timezone,omitempty"`
+	ReceiverTimezone string                 `protobuf:"bytes,4,opt,name=receiver_timezone,json=receiverTimezone,proto3" json:"receiver_timezone,omitempty"`
+	Instant          bool                   `protobuf:"varint,5,opt,name=instant,proto3" json:"instant,omitempty"`
+	Note             string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MessageDeliveryAnswer) Reset() {
+	*x = MessageDeliveryAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDeliveryAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDeliveryAnswer) ProtoMessage() {}
+
+func (x *MessageDeliveryAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDeliveryAnswer.ProtoReflect.Descriptor instead.
+func (*MessageDeliveryAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MessageDeliveryAnswer) GetSendTime() string {
+	if x != nil {
+		return x.SendTime
+	}
+	return ""
+}
+
+func (x *MessageDeliveryAnswer) GetReceiveTime() string {
+	if x != nil {
+		return x.ReceiveTime
+	}
+	return ""
+}
+
+func (x *MessageDeliveryAnswer) GetSenderTimezone() string {
+	if x != nil {
+		return x.SenderTimezone
+	}
+	return ""
+}
+
+func (x *MessageDeliveryAnswer) GetReceiverTimezone() string {
+	if x != nil {
+		return x.ReceiverTimezone
+	}
+	return ""
+}
+
+func (x *MessageDeliveryAnswer) GetInstant() bool {
+	if x != nil {
+		return x.Instant
+	}
+	return false
+}
+
+func (x *MessageDeliveryAnswer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// Вопрос 10
+type UpcomingStationsAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stations      []*Station             `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpcomingStationsAnswer) Reset() {
+	*x = UpcomingStationsAnswer{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpcomingStationsAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingStationsAnswer) ProtoMessage() {}
+
+func (x *UpcomingStationsAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingStationsAnswer.ProtoReflect.Descriptor instead.
+func (*UpcomingStationsAnswer) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpcomingStationsAnswer) GetStations() []*Station {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+type AskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      int32                  `protobuf:"varint,1,opt,name=question,proto3" json:"question,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Lang          string                 `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskRequest) Reset() {
+	*x = AskRequest{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskRequest) ProtoMessage() {}
+
+func (x *AskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskRequest.ProtoReflect.Descriptor instead.
+func (*AskRequest) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AskRequest) GetQuestion() int32 {
+	if x != nil {
+		return x.Question
+	}
+	return 0
+}
+
+func (x *AskRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *AskRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+type AskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      int32                  `protobuf:"varint,1,opt,name=question,proto3" json:"question,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Answer        *structpb.Struct       `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskResponse) Reset() {
+	*x = AskResponse{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskResponse) ProtoMessage() {}
+
+func (x *AskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskResponse.ProtoReflect.Descriptor instead.
+func (*AskResponse) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AskResponse) GetQuestion() int32 {
+	if x != nil {
+		return x.Question
+	}
+	return 0
+}
+
+func (x *AskResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AskResponse) GetAnswer() *structpb.Struct {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type WatchPositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"` // По умолчанию 10 с, не меньше 1 с
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	OnlyChanges   bool                   `protobuf:"varint,3,opt,name=only_changes,json=onlyChanges,proto3" json:"only_changes,omitempty"` // Присылать, только когда сменилась станция или перегон
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPositionRequest) Reset() {
+	*x = WatchPositionRequest{}
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPositionRequest) ProtoMessage() {}
+
+func (x *WatchPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reyna_tracker_v1_tracker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPositionRequest.ProtoReflect.Descriptor instead.
+func (*WatchPositionRequest) Descriptor() ([]byte, []int) {
+	return file_reyna_tracker_v1_tracker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchPositionRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *WatchPositionRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *WatchPositionRequest) GetOnlyChanges() bool {
+	if x != nil {
+		return x.OnlyChanges
+	}
+	return false
+}
+
+var File_reyna_tracker_v1_tracker_proto protoreflect.FileDescriptor
+
+const file_reyna_tracker_v1_tracker_proto_rawDesc = "" +
+	"\n" +
+	"\x1ereyna/tracker/v1/tracker.proto\x12\x10reyna.tracker.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"M\n" +
+	"\vTimeRequest\x12*\n" +
+	"\x02at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"\xe7\x02\n" +
+	"\aStation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12=\n" +
+	"\farrival_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\varrivalTime\x12A\n" +
+	"\x0edeparture_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureTime\x12@\n" +
+	"\x0estand_duration\x18\a \x01(\v2\x19.google.protobuf.DurationR\rstandDuration\x12\x1f\n" +
+	"\vdistance_km\x18\b \x01(\x05R\n" +
+	"distanceKm\x12\x14\n" +
+	"\x05major\x18\t \x01(\bR\x05major\"\xf9\x02\n" +
+	"\bPosition\x12*\n" +
+	"\x02at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x1d\n" +
+	"\n" +
+	"at_station\x18\x02 \x01(\bR\tatStation\x12B\n" +
+	"\x0fcurrent_station\x18\x03 \x01(\v2\x19.reyna.tracker.v1.StationR\x0ecurrentStation\x12D\n" +
+	"\x10previous_station\x18\x04 \x01(\v2\x19.reyna.tracker.v1.StationR\x0fpreviousStation\x12<\n" +
+	"\fnext_station\x18\x05 \x01(\v2\x19.reyna.tracker.v1.StationR\vnextStation\x12\x1f\n" +
+	"\vdistance_km\x18\x06 \x01(\x01R\n" +
+	"distanceKm\x12\x1d\n" +
+	"\n" +
+	"local_time\x18\a \x01(\tR\tlocalTime\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\"\xa6\x01\n" +
+	"\vTrainStatus\x12\x16\n" +
+	"\x06moving\x18\x01 \x01(\bR\x06moving\x12B\n" +
+	"\x0fremaining_stand\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0eremainingStand\x12;\n" +
+	"\ftime_to_next\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"timeToNext\"\xd5\x01\n" +
+	"\n" +
+	"DateChange\x12*\n" +
+	"\x02at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12)\n" +
+	"\x02in\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x02in\x12\x19\n" +
+	"\bnew_date\x18\x03 \x01(\tR\anewDate\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x18\n" +
+	"\astation\x18\x05 \x01(\tR\astation\x12\x1f\n" +
+	"\vclock_shift\x18\x06 \x01(\bR\n" +
+	"clockShift\"\xba\x02\n" +
+	"\vJourneyInfo\x12\x1d\n" +
+	"\n" +
+	"day_number\x18\x01 \x01(\x05R\tdayNumber\x12!\n" +
+	"\fcalendar_day\x18\x02 \x01(\x05R\vcalendarDay\x12\x1d\n" +
+	"\n" +
+	"local_date\x18\x03 \x01(\tR\tlocalDate\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x120\n" +
+	"\x05start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12;\n" +
+	"\ftime_in_trip\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"timeInTrip\x12?\n" +
+	"\fdate_changes\x18\a \x03(\v2\x1c.reyna.tracker.v1.DateChangeR\vdateChanges\"L\n" +
+	"\x0fLocalTimeAnswer\x12\x1d\n" +
+	"\n" +
+	"local_time\x18\x01 \x01(\tR\tlocalTime\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"\xa0\x01\n" +
+	"\x14CurrentStationAnswer\x12\x1d\n" +
+	"\n" +
+	"at_station\x18\x01 \x01(\bR\tatStation\x12\x18\n" +
+	"\astation\x18\x02 \x01(\tR\astation\x12\x1a\n" +
+	"\bprevious\x18\x03 \x01(\tR\bprevious\x12\x12\n" +
+	"\x04next\x18\x04 \x01(\tR\x04next\x12\x1f\n" +
+	"\vdistance_km\x18\x05 \x01(\x05R\n" +
+	"distanceKm\"\xc3\x02\n" +
+	"\x10TrainStateAnswer\x12\x16\n" +
+	"\x06moving\x18\x01 \x01(\bR\x06moving\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\astation\x18\x03 \x01(\tR\astation\x12@\n" +
+	"\x0estand_duration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rstandDuration\x12B\n" +
+	"\x0fremaining_stand\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0eremainingStand\x12\x12\n" +
+	"\x04from\x18\x06 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\a \x01(\tR\x02to\x12;\n" +
+	"\ftime_to_next\x18\b \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"timeToNext\"u\n" +
+	"\x10JourneyDayAnswer\x127\n" +
+	"\ajourney\x18\x01 \x01(\v2\x1d.reyna.tracker.v1.JourneyInfoR\ajourney\x12(\n" +
+	"\x10next_date_change\x18\x02 \x01(\tR\x0enextDateChange\"M\n" +
+	"\x0eDistanceAnswer\x12\x1f\n" +
+	"\vdistance_km\x18\x01 \x01(\x05R\n" +
+	"distanceKm\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\"\xc2\x03\n" +
+	"\x11NextArrivalAnswer\x12\x18\n" +
+	"\astation\x18\x01 \x01(\tR\astation\x128\n" +
+	"\tscheduled\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tscheduled\x126\n" +
+	"\bexpected\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bexpected\x126\n" +
+	"\bearliest\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bearliest\x122\n" +
+	"\x06latest\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06latest\x12/\n" +
+	"\x05delay\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x05delay\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\a \x01(\x01R\n" +
+	"confidence\x12\"\n" +
+	"\fobservations\x18\b \x01(\x05R\fobservations\x12@\n" +
+	"\x0etime_remaining\x18\t \x01(\v2\x19.google.protobuf.DurationR\rtimeRemaining\"\xa6\x01\n" +
+	"\vClockChange\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12/\n" +
+	"\x05shift\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05shift\x12)\n" +
+	"\x02in\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x02in\x12\x1f\n" +
+	"\vdistance_km\x18\x04 \x01(\x01R\n" +
+	"distanceKm\"\xf8\x01\n" +
+	"\x14TimeDifferenceAnswer\x12\x1f\n" +
+	"\vmoscow_time\x18\x01 \x01(\tR\n" +
+	"moscowTime\x12\x1d\n" +
+	"\n" +
+	"local_time\x18\x02 \x01(\tR\tlocalTime\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x129\n" +
+	"\n" +
+	"difference\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"difference\x12I\n" +
+	"\x11next_clock_change\x18\x05 \x01(\v2\x1d.reyna.tracker.v1.ClockChangeR\x0fnextClockChange\"\xdb\x01\n" +
+	"\x15MessageDeliveryAnswer\x12\x1b\n" +
+	"\tsend_time\x18\x01 \x01(\tR\bsendTime\x12!\n" +
+	"\freceive_time\x18\x02 \x01(\tR\vreceiveTime\x12'\n" +
+	"\x0fsender_timezone\x18\x03 \x01(\tR\x0esenderTimezone\x12+\n" +
+	"\x11receiver_timezone\x18\x04 \x01(\tR\x10receiverTimezone\x12\x18\n" +
+	"\ainstant\x18\x05 \x01(\bR\ainstant\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"O\n" +
+	"\x16UpcomingStationsAnswer\x125\n" +
+	"\bstations\x18\x01 \x03(\v2\x19.reyna.tracker.v1.StationR\bstations\"h\n" +
+	"\n" +
+	"AskRequest\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\x05R\bquestion\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x12\n" +
+	"\x04lang\x18\x03 \x01(\tR\x04lang\"n\n" +
+	"\vAskResponse\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\x05R\bquestion\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12/\n" +
+	"\x06answer\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06answer\"\x84\x01\n" +
+	"\x14WatchPositionRequest\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\x12!\n" +
+	"\fonly_changes\x18\x03 \x01(\bR\vonlyChanges2\xde\t\n" +
+	"\x0eTrackerService\x12H\n" +
+	"\vGetPosition\x12\x1d.reyna.tracker.v1.TimeRequest\x1a\x1a.reyna.tracker.v1.Position\x12I\n" +
+	"\tGetStatus\x12\x1d.reyna.tracker.v1.TimeRequest\x1a\x1d.reyna.tracker.v1.TrainStatus\x12J\n" +
+	"\n" +
+	"GetJourney\x12\x1d.reyna.tracker.v1.TimeRequest\x1a\x1d.reyna.tracker.v1.JourneyInfo\x12M\n" +
+	"\tLocalTime\x12\x1d.reyna.tracker.v1.TimeRequest\x1a!.reyna.tracker.v1.LocalTimeAnswer\x12W\n" +
+	"\x0eCurrentStation\x12\x1d.reyna.tracker.v1.TimeRequest\x1a&.reyna.tracker.v1.CurrentStationAnswer\x12O\n" +
+	"\n" +
+	"TrainState\x12\x1d.reyna.tracker.v1.TimeRequest\x1a\".reyna.tracker.v1.TrainStateAnswer\x12O\n" +
+	"\n" +
+	"JourneyDay\x12\x1d.reyna.tracker.v1.TimeRequest\x1a\".reyna.tracker.v1.JourneyDayAnswer\x12K\n" +
+	"\bDistance\x12\x1d.reyna.tracker.v1.TimeRequest\x1a .reyna.tracker.v1.DistanceAnswer\x12Q\n" +
+	"\vNextArrival\x12\x1d.reyna.tracker.v1.TimeRequest\x1a#.reyna.tracker.v1.NextArrivalAnswer\x12W\n" +
+	"\x0eTimeDifference\x12\x1d.reyna.tracker.v1.TimeRequest\x1a&.reyna.tracker.v1.TimeDifferenceAnswer\x12V\n" +
+	"\fMessageToHer\x12\x1d.reyna.tracker.v1.TimeRequest\x1a'.reyna.tracker.v1.MessageDeliveryAnswer\x12X\n" +
+	"\x0eMessageFromHer\x12\x1d.reyna.tracker.v1.TimeRequest\x1a'.reyna.tracker.v1.MessageDeliveryAnswer\x12[\n" +
+	"\x10UpcomingStations\x12\x1d.reyna.tracker.v1.TimeRequest\x1a(.reyna.tracker.v1.UpcomingStationsAnswer\x12B\n" +
+	"\x03Ask\x12\x1c.reyna.tracker.v1.AskRequest\x1a\x1d.reyna.tracker.v1.AskResponse\x12U\n" +
+	"\rWatchPosition\x12&.reyna.tracker.v1.WatchPositionRequest\x1a\x1a.reyna.tracker.v1.Position0\x01B-Z+reyna-train-tracker/pkg/trackerpb;trackerpbb\x06proto3"
+
+var (
+	file_reyna_tracker_v1_tracker_proto_rawDescOnce sync.Once
+	file_reyna_tracker_v1_tracker_proto_rawDescData []byte
+)
+
+func file_reyna_tracker_v1_tracker_proto_rawDescGZIP() []byte {
+	file_reyna_tracker_v1_tracker_proto_rawDescOnce.Do(func() {
+		file_reyna_tracker_v1_tracker_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reyna_tracker_v1_tracker_proto_rawDesc), len(file_reyna_tracker_v1_tracker_proto_rawDesc)))
+	})
+	return file_reyna_tracker_v1_tracker_proto_rawDescData
+}
+
+var file_reyna_tracker_v1_tracker_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_reyna_tracker_v1_tracker_proto_goTypes = []any{
+	(*TimeRequest)(nil),            // 0: reyna.tracker.v1.TimeRequest
+	(*Station)(nil),                // 1: reyna.tracker.v1.Station
+	(*Position)(nil),               // 2: reyna.tracker.v1.Position
+	(*TrainStatus)(nil),            // 3: reyna.tracker.v1.TrainStatus
+	(*DateChange)(nil),             // 4: reyna.tracker.v1.DateChange
+	(*JourneyInfo)(nil),            // 5: reyna.tracker.v1.JourneyInfo
+	(*LocalTimeAnswer)(nil),        // 6: reyna.tracker.v1.LocalTimeAnswer
+	(*CurrentStationAnswer)(nil),   // 7: reyna.tracker.v1.CurrentStationAnswer
+	(*TrainStateAnswer)(nil),       // 8: reyna.tracker.v1.TrainStateAnswer
+	(*JourneyDayAnswer)(nil),       // 9: reyna.tracker.v1.JourneyDayAnswer
+	(*DistanceAnswer)(nil),         // 10: reyna.tracker.v1.DistanceAnswer
+	(*NextArrivalAnswer)(nil),      // 11: reyna.tracker.v1.NextArrivalAnswer
+	(*ClockChange)(nil),            // 12: reyna.tracker.v1.ClockChange
+	(*TimeDifferenceAnswer)(nil),   // 13: reyna.tracker.v1.TimeDifferenceAnswer
+	(*MessageDeliveryAnswer)(nil),  // 14: reyna.tracker.v1.MessageDeliveryAnswer
+	(*UpcomingStationsAnswer)(nil), // 15: reyna.tracker.v1.UpcomingStationsAnswer
+	(*AskRequest)(nil),             // 16: reyna.tracker.v1.AskRequest
+	(*AskResponse)(nil),            // 17: reyna.tracker.v1.AskResponse
+	(*WatchPositionRequest)(nil),   // 18: reyna.tracker.v1.WatchPositionRequest
+	(*timestamppb.Timestamp)(nil),  // 19: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 20: google.protobuf.Duration
+	(*structpb.Struct)(nil),        // 21: google.protobuf.Struct
+}
+var file_reyna_tracker_v1_tracker_proto_depIdxs = []int32{
+	19, // 0: reyna.tracker.v1.TimeRequest.at:type_name -> google.protobuf.Timestamp
+	19, // 1: reyna.tracker.v1.Station.arrival_time:type_name -> google.protobuf.Timestamp
+	19, // 2: reyna.tracker.v1.Station.departure_time:type_name -> google.protobuf.Timestamp
+	20, // 3: reyna.tracker.v1.Station.stand_duration:type_name -> google.protobuf.Duration
+	19, // 4: reyna.tracker.v1.Position.at:type_name -> google.protobuf.Timestamp
+	1,  // 5: reyna.tracker.v1.Position.current_station:type_name -> reyna.tracker.v1.Station
+	1,  // 6: reyna.tracker.v1.Position.previous_station:type_name -> reyna.tracker.v1.Station
+	1,  // 7: reyna.tracker.v1.Position.next_station:type_name -> reyna.tracker.v1.Station
+	20, // 8: reyna.tracker.v1.TrainStatus.remaining_stand:type_name -> google.protobuf.Duration
+	20, // 9: reyna.tracker.v1.TrainStatus.time_to_next:type_name -> google.protobuf.Duration
+	19, // 10: reyna.tracker.v1.DateChange.at:type_name -> google.protobuf.Timestamp
+	20, // 11: reyna.tracker.v1.DateChange.in:type_name -> google.protobuf.Duration
+	19, // 12: reyna.tracker.v1.JourneyInfo.start:type_name -> google.protobuf.Timestamp
+	20, // 13: reyna.tracker.v1.JourneyInfo.time_in_trip:type_name -> google.protobuf.Duration
+	4,  // 14: reyna.tracker.v1.JourneyInfo.date_changes:type_name -> reyna.tracker.v1.DateChange
+	20, // 15: reyna.tracker.v1.TrainStateAnswer.stand_duration:type_name -> google.protobuf.Duration
+	20, // 16: reyna.tracker.v1.TrainStateAnswer.remaining_stand:type_name -> google.protobuf.Duration
+	20, // 17: reyna.tracker.v1.TrainStateAnswer.time_to_next:type_name -> google.protobuf.Duration
+	5,  // 18: reyna.tracker.v1.JourneyDayAnswer.journey:type_name -> reyna.tracker.v1.JourneyInfo
+	19, // 19: reyna.tracker.v1.NextArrivalAnswer.scheduled:type_name -> google.protobuf.Timestamp
+	19, // 20: reyna.tracker.v1.NextArrivalAnswer.expected:type_name -> google.protobuf.Timestamp
+	19, // 21: reyna.tracker.v1.NextArrivalAnswer.earliest:type_name -> google.protobuf.Timestamp
+	19, // 22: reyna.tracker.v1.NextArrivalAnswer.latest:type_name -> google.protobuf.Timestamp
+	20, // 23: reyna.tracker.v1.NextArrivalAnswer.delay:type_name -> google.protobuf.Duration
+	20, // 24: reyna.tracker.v1.NextArrivalAnswer.time_remaining:type_name -> google.protobuf.Duration
+	20, // 25: reyna.tracker.v1.ClockChange.shift:type_name -> google.protobuf.Duration
+	20, // 26: reyna.tracker.v1.ClockChange.in:type_name -> google.protobuf.Duration
+	20, // 27: reyna.tracker.v1.TimeDifferenceAnswer.difference:type_name -> google.protobuf.Duration
+	12, // 28: reyna.tracker.v1.TimeDifferenceAnswer.next_clock_change:type_name -> reyna.tracker.v1.ClockChange
+	1,  // 29: reyna.tracker.v1.UpcomingStationsAnswer.stations:type_name -> reyna.tracker.v1.Station
+	19, // 30: reyna.tracker.v1.AskRequest.at:type_name -> google.protobuf.Timestamp
+	21, // 31: reyna.tracker.v1.AskResponse.answer:type_name -> google.protobuf.Struct
+	20, // 32: reyna.tracker.v1.WatchPositionRequest.interval:type_name -> google.protobuf.Duration
+	0,  // 33: reyna.tracker.v1.TrackerService.GetPosition:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 34: reyna.tracker.v1.TrackerService.GetStatus:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 35: reyna.tracker.v1.TrackerService.GetJourney:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 36: reyna.tracker.v1.TrackerService.LocalTime:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 37: reyna.tracker.v1.TrackerService.CurrentStation:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 38: reyna.tracker.v1.TrackerService.TrainState:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 39: reyna.tracker.v1.TrackerService.JourneyDay:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 40: reyna.tracker.v1.TrackerService.Distance:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 41: reyna.tracker.v1.TrackerService.NextArrival:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 42: reyna.tracker.v1.TrackerService.TimeDifference:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 43: reyna.tracker.v1.TrackerService.MessageToHer:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 44: reyna.tracker.v1.TrackerService.MessageFromHer:input_type -> reyna.tracker.v1.TimeRequest
+	0,  // 45: reyna.tracker.v1.TrackerService.UpcomingStations:input_type -> reyna.tracker.v1.TimeRequest
+	16, // 46: reyna.tracker.v1.TrackerService.Ask:input_type -> reyna.tracker.v1.AskRequest
+	18, // 47: reyna.tracker.v1.TrackerService.WatchPosition:input_type -> reyna.tracker.v1.WatchPositionRequest
+	2,  // 48: reyna.tracker.v1.TrackerService.GetPosition:output_type -> reyna.tracker.v1.Position
+	3,  // 49: reyna.tracker.v1.TrackerService.GetStatus:output_type -> reyna.tracker.v1.TrainStatus
+	5,  // 50: reyna.tracker.v1.TrackerService.GetJourney:output_type -> reyna.tracker.v1.JourneyInfo
+	6,  // 51: reyna.tracker.v1.TrackerService.LocalTime:output_type -> reyna.tracker.v1.LocalTimeAnswer
+	7,  // 52: reyna.tracker.v1.TrackerService.CurrentStation:output_type -> reyna.tracker.v1.CurrentStationAnswer
+	8,  // 53: reyna.tracker.v1.TrackerService.TrainState:output_type -> reyna.tracker.v1.TrainStateAnswer
+	9,  // 54: reyna.tracker.v1.TrackerService.JourneyDay:output_type -> reyna.tracker.v1.JourneyDayAnswer
+	10, // 55: reyna.tracker.v1.TrackerService.Distance:output_type -> reyna.tracker.v1.DistanceAnswer
+	11, // 56: reyna.tracker.v1.TrackerService.NextArrival:output_type -> reyna.tracker.v1.NextArrivalAnswer
+	13, // 57: reyna.tracker.v1.TrackerService.TimeDifference:output_type -> reyna.tracker.v1.TimeDifferenceAnswer
+	14, // 58: reyna.tracker.v1.TrackerService.MessageToHer:output_type -> reyna.tracker.v1.MessageDeliveryAnswer
+	14, // 59: reyna.tracker.v1.TrackerService.MessageFromHer:output_type -> reyna.tracker.v1.MessageDeliveryAnswer
+	15, // 60: reyna.tracker.v1.TrackerService.UpcomingStations:output_type -> reyna.tracker.v1.UpcomingStationsAnswer
+	17, // 61: reyna.tracker.v1.TrackerService.Ask:output_type -> reyna.tracker.v1.AskResponse
+	2,  // 62: reyna.tracker.v1.TrackerService.WatchPosition:output_type -> reyna.tracker.v1.Position
+	48, // [48:63] is the sub-list for method output_type
+	33, // [33:48] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_reyna_tracker_v1_tracker_proto_init() }
+func file_reyna_tracker_v1_tracker_proto_init() {
+	if File_reyna_tracker_v1_tracker_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reyna_tracker_v1_tracker_proto_rawDesc), len(file_reyna_tracker_v1_tracker_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reyna_tracker_v1_tracker_proto_goTypes,
+		DependencyIndexes: file_reyna_tracker_v1_tracker_proto_depIdxs,
+		MessageInfos:      file_reyna_tracker_v1_tracker_proto_msgTypes,
+	}.Build()
+	File_reyna_tracker_v1_tracker_proto = out.File
+	file_reyna_tracker_v1_tracker_proto_goTypes = nil
+	file_reyna_tracker_v1_tracker_proto_depIdxs = nil
+}
@@ -0,0 +1,673 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: reyna/tracker/v1/tracker.proto
+
+// gRPC API трекера: те же данные, что в REST, но с типизированными ответами
+
+package trackerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TrackerService_GetPosition_FullMethodName      = "/reyna.tracker.v1.TrackerService/GetPosition"
+	TrackerService_GetStatus_FullMethodName        = "/reyna.tracker.v1.TrackerService/GetStatus"
+	TrackerService_GetJourney_FullMethodName       = "/reyna.tracker.v1.TrackerService/GetJourney"
+	TrackerService_LocalTime_FullMethodName        = "/reyna.tracker.v1.TrackerService/LocalTime"
+	TrackerService_CurrentStation_FullMethodName   = "/reyna.tracker.v1.TrackerService/CurrentStation"
+	TrackerService_TrainState_FullMethodName       = "/reyna.tracker.v1.TrackerService/TrainState"
+	TrackerService_JourneyDay_FullMethodName       = "/reyna.tracker.v1.TrackerService/JourneyDay"
+	TrackerService_Distance_FullMethodName         = "/reyna.tracker.v1.TrackerService/Distance"
+	TrackerService_NextArrival_FullMethodName      = "/reyna.tracker.v1.TrackerService/NextArrival"
+	TrackerService_TimeDifference_FullMethodName   = "/reyna.tracker.v1.TrackerService/TimeDifference"
+	TrackerService_MessageToHer_FullMethodName     = "/reyna.tracker.v1.TrackerService/MessageToHer"
+	TrackerService_MessageFromHer_FullMethodName   = "/reyna.tracker.v1.TrackerService/MessageFromHer"
+	TrackerService_UpcomingStations_FullMethodName = "/reyna.tracker.v1.TrackerService/UpcomingStations"
+	TrackerService_Ask_FullMethodName              = "/reyna.tracker.v1.TrackerService/Ask"
+	TrackerService_WatchPosition_FullMethodName    = "/reyna.tracker.v1.TrackerService/WatchPosition"
+)
+
+// TrackerServiceClient is the client API for TrackerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TrackerService позиция поезда, статус, путешествие и ответы на вопросы.
+// Во всех запросах момент at необязателен: без него берётся время по часам трекера.
+// Язык lang ("ru", "en"); без него - метаданные accept-language, затем язык сервера
+type TrackerServiceClient interface {
+	GetPosition(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*Position, error)
+	GetStatus(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*TrainStatus, error)
+	GetJourney(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*JourneyInfo, error)
+	// Вопросы 1-10
+	LocalTime(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*LocalTimeAnswer, error)
+	CurrentStation(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*CurrentStationAnswer, error)
+	TrainState(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*TrainStateAnswer, error)
+	JourneyDay(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*JourneyDayAnswer, error)
+	Distance(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*DistanceAnswer, error)
+	NextArrival(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*NextArrivalAnswer, error)
+	TimeDifference(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*TimeDifferenceAnswer, error)
+	MessageToHer(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*MessageDeliveryAnswer, error)
+	MessageFromHer(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*MessageDeliveryAnswer, error)
+	UpcomingStations(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*UpcomingStationsAnswer, error)
+	// Ask любой вопрос из реестра, включая подключённые плагинами (ответ без схемы)
+	Ask(ctx context.Context, in *AskRequest, opts ...grpc.CallOption) (*AskResponse, error)
+	// WatchPosition присылает позицию сразу и затем каждые interval по часам трекера
+	WatchPosition(ctx context.Context, in *WatchPositionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Position], error)
+}
+
+type trackerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrackerServiceClient(cc grpc.ClientConnInterface) TrackerServiceClient {
+	return &trackerServiceClient{cc}
+}
+
+func (c *trackerServiceClient) GetPosition(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*Position, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Position)
+	err := c.cc.Invoke(ctx, TrackerService_GetPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetStatus(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*TrainStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrainStatus)
+	err := c.cc.Invoke(ctx, TrackerService_GetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetJourney(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*JourneyInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JourneyInfo)
+	err := c.cc.Invoke(ctx, TrackerService_GetJourney_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) LocalTime(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*LocalTimeAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocalTimeAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_LocalTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CurrentStation(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*CurrentStationAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CurrentStationAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_CurrentStation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) TrainState(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*TrainStateAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrainStateAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_TrainState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) JourneyDay(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*JourneyDayAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JourneyDayAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_JourneyDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) Distance(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*DistanceAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DistanceAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_Distance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) NextArrival(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*NextArrivalAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NextArrivalAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_NextArrival_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) TimeDifference(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*TimeDifferenceAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeDifferenceAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_TimeDifference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) MessageToHer(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*MessageDeliveryAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageDeliveryAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_MessageToHer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) MessageFromHer(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*MessageDeliveryAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageDeliveryAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_MessageFromHer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) UpcomingStations(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*UpcomingStationsAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpcomingStationsAnswer)
+	err := c.cc.Invoke(ctx, TrackerService_UpcomingStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) Ask(ctx context.Context, in *AskRequest, opts ...grpc.CallOption) (*AskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AskResponse)
+	err := c.cc.Invoke(ctx, TrackerService_Ask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) WatchPosition(ctx context.Context, in *WatchPositionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Position], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TrackerService_ServiceDesc.Streams[0], TrackerService_WatchPosition_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPositionRequest, Position]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TrackerService_WatchPositionClient = grpc.ServerStreamingClient[Position]
+
+// TrackerServiceServer is the server API for TrackerService service.
+// All implementations must embed UnimplementedTrackerServiceServer
+// for forward compatibility.
+//
+// TrackerService позиция поезда, статус, путешествие и ответы на вопросы.
+// Во всех запросах момент at необязателен: без него берётся время по часам трекера.
+// Язык lang ("ru", "en"); без него - метаданные accept-language, затем язык сервера
+type TrackerServiceServer interface {
+	GetPosition(context.Context, *TimeRequest) (*Position, error)
+	GetStatus(context.Context, *TimeRequest) (*TrainStatus, error)
+	GetJourney(context.Context, *TimeRequest) (*JourneyInfo, error)
+	// Вопросы 1-10
+	LocalTime(context.Context, *TimeRequest) (*LocalTimeAnswer, error)
+	CurrentStation(context.Context, *TimeRequest) (*CurrentStationAnswer, error)
+	TrainState(context.Context, *TimeRequest) (*TrainStateAnswer, error)
+	JourneyDay(context.Context, *TimeRequest) (*JourneyDayAnswer, error)
+	Distance(context.Context, *TimeRequest) (*DistanceAnswer, error)
+	NextArrival(context.Context, *TimeRequest) (*NextArrivalAnswer, error)
+	TimeDifference(context.Context, *TimeRequest) (*TimeDifferenceAnswer, error)
+	MessageToHer(context.Context, *TimeRequest) (*MessageDeliveryAnswer, error)
+	MessageFromHer(context.Context, *TimeRequest) (*MessageDeliveryAnswer, error)
+	UpcomingStations(context.Context, *TimeRequest) (*UpcomingStationsAnswer, error)
+	// Ask любой вопрос из реестра, включая подключённые плагинами (ответ без схемы)
+	Ask(context.Context, *AskRequest) (*AskResponse, error)
+	// WatchPosition присылает позицию сразу и затем каждые interval по часам трекера
+	WatchPosition(*WatchPositionRequest, grpc.ServerStreamingServer[Position]) error
+	mustEmbedUnimplementedTrackerServiceServer()
+}
+
+// UnimplementedTrackerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTrackerServiceServer struct{}
+
+func (UnimplementedTrackerServiceServer) GetPosition(context.Context, *TimeRequest) (*Position, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPosition not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetStatus(context.Context, *TimeRequest) (*TrainStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetJourney(context.Context, *TimeRequest) (*JourneyInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJourney not implemented")
+}
+func (UnimplementedTrackerServiceServer) LocalTime(context.Context, *TimeRequest) (*LocalTimeAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method LocalTime not implemented")
+}
+func (UnimplementedTrackerServiceServer) CurrentStation(context.Context, *TimeRequest) (*CurrentStationAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method CurrentStation not implemented")
+}
+func (UnimplementedTrackerServiceServer) TrainState(context.Context, *TimeRequest) (*TrainStateAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method TrainState not implemented")
+}
+func (UnimplementedTrackerServiceServer) JourneyDay(context.Context, *TimeRequest) (*JourneyDayAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method JourneyDay not implemented")
+}
+func (UnimplementedTrackerServiceServer) Distance(context.Context, *TimeRequest) (*DistanceAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method Distance not implemented")
+}
+func (UnimplementedTrackerServiceServer) NextArrival(context.Context, *TimeRequest) (*NextArrivalAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method NextArrival not implemented")
+}
+func (UnimplementedTrackerServiceServer) TimeDifference(context.Context, *TimeRequest) (*TimeDifferenceAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method TimeDifference not implemented")
+}
+func (UnimplementedTrackerServiceServer) MessageToHer(context.Context, *TimeRequest) (*MessageDeliveryAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method MessageToHer not implemented")
+}
+func (UnimplementedTrackerServiceServer) MessageFromHer(context.Context, *TimeRequest) (*MessageDeliveryAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method MessageFromHer not implemented")
+}
+func (UnimplementedTrackerServiceServer) UpcomingStations(context.Context, *TimeRequest) (*UpcomingStationsAnswer, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpcomingStations not implemented")
+}
+func (UnimplementedTrackerServiceServer) Ask(context.Context, *AskRequest) (*AskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Ask not implemented")
+}
+func (UnimplementedTrackerServiceServer) WatchPosition(*WatchPositionRequest, grpc.ServerStreamingServer[Position]) error {
+	return status.Error(codes.Unimplemented, "method WatchPosition not implemented")
+}
+func (UnimplementedTrackerServiceServer) mustEmbedUnimplementedTrackerServiceServer() {}
+func (UnimplementedTrackerServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTrackerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrackerServiceServer will
+// result in compilation errors.
+type UnsafeTrackerServiceServer interface {
+	mustEmbedUnimplementedTrackerServiceServer()
+}
+
+func RegisterTrackerServiceServer(s grpc.ServiceRegistrar, srv TrackerServiceServer) {
+	// If the following call panics, it indicates UnimplementedTrackerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TrackerService_ServiceDesc, srv)
+}
+
+func _TrackerService_GetPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetPosition(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetStatus(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetJourney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetJourney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetJourney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetJourney(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_LocalTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).LocalTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_LocalTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).LocalTime(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CurrentStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CurrentStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CurrentStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CurrentStation(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_TrainState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).TrainState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_TrainState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).TrainState(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_JourneyDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).JourneyDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_JourneyDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).JourneyDay(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_Distance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).Distance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_Distance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).Distance(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_NextArrival_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).NextArrival(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_NextArrival_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).NextArrival(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_TimeDifference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).TimeDifference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_TimeDifference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).TimeDifference(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_MessageToHer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).MessageToHer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_MessageToHer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).MessageToHer(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_MessageFromHer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).MessageFromHer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_MessageFromHer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).MessageFromHer(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UpcomingStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UpcomingStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UpcomingStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UpcomingStations(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_Ask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).Ask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_Ask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).Ask(ctx, req.(*AskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_WatchPosition_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPositionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrackerServiceServer).WatchPosition(m, &grpc.GenericServerStream[WatchPositionRequest, Position]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TrackerService_WatchPositionServer = grpc.ServerStreamingServer[Position]
+
+// TrackerService_ServiceDesc is the grpc.ServiceDesc for TrackerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrackerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reyna.tracker.v1.TrackerService",
+	HandlerType: (*TrackerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPosition",
+			Handler:    _TrackerService_GetPosition_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _TrackerService_GetStatus_Handler,
+		},
+		{
+			MethodName: "GetJourney",
+			Handler:    _TrackerService_GetJourney_Handler,
+		},
+		{
+			MethodName: "LocalTime",
+			Handler:    _TrackerService_LocalTime_Handler,
+		},
+		{
+			MethodName: "CurrentStation",
+			Handler:    _TrackerService_CurrentStation_Handler,
+		},
+		{
+			MethodName: "TrainState",
+			Handler:    _TrackerService_TrainState_Handler,
+		},
+		{
+			MethodName: "JourneyDay",
+			Handler:    _TrackerService_JourneyDay_Handler,
+		},
+		{
+			MethodName: "Distance",
+			Handler:    _TrackerService_Distance_Handler,
+		},
+		{
+			MethodName: "NextArrival",
+			Handler:    _TrackerService_NextArrival_Handler,
+		},
+		{
+			MethodName: "TimeDifference",
+			Handler:    _TrackerService_TimeDifference_Handler,
+		},
+		{
+			MethodName: "MessageToHer",
+			Handler:    _TrackerService_MessageToHer_Handler,
+		},
+		{
+			MethodName: "MessageFromHer",
+			Handler:    _TrackerService_MessageFromHer_Handler,
+		},
+		{
+			MethodName: "UpcomingStations",
+			Handler:    _TrackerService_UpcomingStations_Handler,
+		},
+		{
+			MethodName: "Ask",
+			Handler:    _TrackerService_Ask_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPosition",
+			Handler:       _TrackerService_WatchPosition_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "reyna/tracker/v1/tracker.proto",
+}